@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// capacity units understood by parseCapacity, weka (and this provider)
+// treat GB/TB as powers of 1000 and GiB/TiB as powers of 1024.
+var capacityUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"pb":  1e15,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+	"pib": 1 << 50,
+}
+
+var capacityRegexp = regexp.MustCompile(`^\s*([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)\s*$`)
+
+// parseCapacity converts a human readable capacity such as "10TiB" or
+// "500 GB" into a number of bytes.
+func parseCapacity(s string) (int64, error) {
+	m := capacityRegexp.FindStringSubmatch(s)
+
+	if m == nil {
+		return 0, fmt.Errorf("cannot parse capacity %q, expected a number followed by a unit such as GB, GiB, TB or TiB", s)
+	}
+
+	multiplier, ok := capacityUnits[strings.ToLower(m[2])]
+
+	if !ok {
+		return 0, fmt.Errorf("unknown capacity unit %q in %q", m[2], s)
+	}
+
+	n, err := strconv.ParseFloat(m[1], 64)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(math.Round(n * multiplier)), nil
+}
+
+// formatCapacity is the reverse of parseCapacity, using the largest
+// unit that represents the value exactly.
+func formatCapacity(bytes int64) string {
+	for _, u := range []string{"PiB", "PB", "TiB", "TB", "GiB", "GB", "MiB", "MB", "KiB", "KB"} {
+		m := int64(capacityUnits[strings.ToLower(u)])
+
+		if bytes != 0 && bytes%m == 0 {
+			return fmt.Sprintf("%d%s", bytes/m, u)
+		}
+	}
+
+	return fmt.Sprintf("%dB", bytes)
+}
+
+func validateCapacity(val any, key string) (warns []string, errs []error) {
+	if _, err := parseCapacity(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q: %s", key, err))
+	}
+
+	return
+}
+
+// capacitiesWithin returns true if both capacities parse and are no
+// more than tolerance bytes apart.
+func capacitiesWithin(old, new string, tolerance int64) bool {
+	o, err := parseCapacity(old)
+
+	if err != nil {
+		return false
+	}
+
+	n, err := parseCapacity(new)
+
+	if err != nil {
+		return false
+	}
+
+	diff := o - n
+
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return diff <= tolerance
+}
+
+// capacities are equal if they parse to within a gigabyte of each
+// other, regardless of how they're written. weka rounds capacities
+// internally so an exact match can't be expected.
+func capacityDiff(k, old, new string, d *schema.ResourceData) bool {
+	return capacitiesWithin(old, new, OurGb-1)
+}
+
+// capacities are equal only if they parse to exactly the same number of
+// bytes, for places where weka stores what it's given without rounding.
+func capacityExactDiff(k, old, new string, d *schema.ResourceData) bool {
+	return capacitiesWithin(old, new, 0)
+}
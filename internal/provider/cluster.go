@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type WekaCluster struct {
+	Data struct {
+		Name      string `json:"name"`
+		GUID      string `json:"guid"`
+		Status    string `json:"status"`
+		Release   string `json:"release"`
+		IOStatus  string `json:"io_status"`
+		Licensing struct {
+			Mode string `json:"mode"`
+		} `json:"licensing"`
+		Capacity struct {
+			TotalBytes         int64 `json:"total_bytes"`
+			HotSpareBytes      int64 `json:"hot_spare_bytes"`
+			UnprovisionedBytes int64 `json:"unprovisioned_bytes"`
+		} `json:"capacity"`
+		StripeDataDrives       int `json:"stripe_data_drives"`
+		StripeProtectionDrives int `json:"stripe_protection_drives"`
+		HotSpare               int `json:"hot_spare"`
+		Rebuild                struct {
+			ProgressPercent float64 `json:"progressPercent"`
+			ProtectionState []struct {
+				NumFailures int     `json:"numFailures"`
+				Percent     float64 `json:"percent"`
+			} `json:"protectionState"`
+		} `json:"rebuild"`
+		Hosts struct {
+			Backends WekaActiveTotal `json:"backends"`
+			Clients  WekaActiveTotal `json:"clients"`
+		} `json:"hosts"`
+		Drives WekaActiveTotal `json:"drives"`
+	} `json:"data"`
+}
+
+type WekaActiveTotal struct {
+	Active int `json:"active"`
+	Total  int `json:"total"`
+}
+
+func getCluster(c *WekaClient) (*WekaCluster, error) {
+	url := c.makeRestEndpointURL("cluster")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var cluster WekaCluster
+
+	if err := json.Unmarshal(body, &cluster); err != nil {
+		return nil, err
+	}
+
+	return &cluster, nil
+}
+
+// majorVersion returns the major release version of the cluster, e.g 4
+// for 4.2.1, or 0 if it can't be worked out.
+func (c *WekaCluster) majorVersion() int {
+	major, err := strconv.Atoi(strings.SplitN(c.Data.Release, ".", 2)[0])
+
+	if err != nil {
+		return 0
+	}
+
+	return major
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCapacity() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads the cluster's SSD capacity and how much of it, and of the total (SSD plus object store) capacity, is provisioned to and used by filesystems. All values are in bytes.",
+		ReadContext: dataSourceCapacityRead,
+		Schema: map[string]*schema.Schema{
+			"ssd_bytes": {
+				Description: "Usable SSD capacity of the cluster.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"hot_spare_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"unprovisioned_ssd_bytes": {
+				Description: "SSD capacity not yet allocated to filesystems, what new filesystems can be given.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"provisioned_ssd_bytes": {
+				Description: "SSD capacity allocated to filesystems.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_ssd_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"free_ssd_bytes": {
+				Description: "SSD capacity allocated to filesystems but not used by them.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"provisioned_total_bytes": {
+				Description: "Total capacity allocated to filesystems.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_total_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"free_total_bytes": {
+				Description: "Total capacity allocated to filesystems but not used by them.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceCapacityRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filesystems, err := getFilesystems(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var provisioned_ssd, used_ssd, free_ssd, provisioned_total, used_total, free_total int
+
+	for _, fs := range filesystems.Data {
+		provisioned_ssd += fs.SsdBudget
+		used_ssd += fs.UsedSsd
+		free_ssd += fs.FreeSsd
+		provisioned_total += fs.TotalBudget
+		used_total += fs.UsedTotal
+		free_total += fs.FreeTotal
+	}
+
+	d.Set("ssd_bytes", cluster.Data.Capacity.TotalBytes)
+	d.Set("hot_spare_bytes", cluster.Data.Capacity.HotSpareBytes)
+	d.Set("unprovisioned_ssd_bytes", cluster.Data.Capacity.UnprovisionedBytes)
+	d.Set("provisioned_ssd_bytes", provisioned_ssd)
+	d.Set("used_ssd_bytes", used_ssd)
+	d.Set("free_ssd_bytes", free_ssd)
+	d.Set("provisioned_total_bytes", provisioned_total)
+	d.Set("used_total_bytes", used_total)
+	d.Set("free_total_bytes", free_total)
+
+	d.SetId(cluster.Data.GUID)
+
+	return diags
+}
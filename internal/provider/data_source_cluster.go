@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCluster() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads the name, version, licensing and capacity of the cluster.",
+		ReadContext: dataSourceClusterRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"release": {
+				Description: "Weka version the cluster is running, e.g '4.2.1'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"major_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"licensing_mode": {
+				Description: "How the cluster is licensed, e.g 'Unlicensed', 'Classic' or 'PayGo'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"total_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"hot_spare_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"unprovisioned_bytes": {
+				Description: "Capacity not yet allocated to filesystems.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", cluster.Data.Name)
+	d.Set("guid", cluster.Data.GUID)
+	d.Set("release", cluster.Data.Release)
+	d.Set("major_version", cluster.majorVersion())
+	d.Set("licensing_mode", cluster.Data.Licensing.Mode)
+	d.Set("total_bytes", cluster.Data.Capacity.TotalBytes)
+	d.Set("hot_spare_bytes", cluster.Data.Capacity.HotSpareBytes)
+	d.Set("unprovisioned_bytes", cluster.Data.Capacity.UnprovisionedBytes)
+
+	d.SetId(cluster.Data.GUID)
+
+	return diags
+}
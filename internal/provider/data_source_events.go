@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceEvents() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists recent cluster events, for example to check that a change didn't cause any errors.",
+		ReadContext: dataSourceEventsRead,
+		Schema: map[string]*schema.Schema{
+			"start_time": {
+				Description:  "Only return events after this time, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"end_time": {
+				Description:  "Only return events before this time, in RFC3339 format.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"min_severity": {
+				Description:  "Only return events of at least this severity, must be one of: DEBUG, INFO, WARNING, MINOR, MAJOR or CRITICAL.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DEBUG", "INFO", "WARNING", "MINOR", "MAJOR", "CRITICAL"}, false),
+			},
+			"category": {
+				Description: "Only return events in this category, e.g 'Filesystem' or 'Drive'.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WekaEvents struct {
+	Data []struct {
+		Timestamp   string `json:"timestamp"`
+		Severity    string `json:"severity"`
+		Category    string `json:"category"`
+		Type        string `json:"type"`
+		Description string `json:"description"`
+	} `json:"data"`
+}
+
+func dataSourceEventsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	endpoint := c.makeRestEndpointURL("events")
+	query := endpoint.Query()
+
+	query.Set("num_results", strconv.Itoa(d.Get("max_results").(int)))
+
+	for k, param := range map[string]string{
+		"start_time":   "start_time",
+		"end_time":     "end_time",
+		"min_severity": "severity",
+		"category":     "category",
+	} {
+		if v := d.Get(k).(string); v != "" {
+			query.Set(param, v)
+		}
+	}
+
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequest("GET", endpoint.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaEvents
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	events := make([]map[string]interface{}, 0)
+
+	for _, e := range parsed.Data {
+		events = append(events, map[string]interface{}{
+			"timestamp":   e.Timestamp,
+			"severity":    e.Severity,
+			"category":    e.Category,
+			"type":        e.Type,
+			"description": e.Description,
+		})
+	}
+
+	if err := d.Set("events", events); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// filesystemAttributes are the computed attributes shared by the
+// weka_filesystem and weka_filesystems data sources.
+func filesystemAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Description: "Filesystem status as reported by Weka.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"is_ready": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"total_capacity_gb": {
+			Description: "Total capacity in gigabytes, defined as 1000000000 bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"ssd_capacity_gb": {
+			Description: "SSD capacity in gigabytes, defined as 1000000000 bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"used_total": {
+			Description: "Used capacity in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"free_total": {
+			Description: "Free capacity in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"used_ssd": {
+			Description: "Used SSD capacity in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"available_ssd": {
+			Description: "Available SSD capacity in bytes.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"tiered": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"obs_names": {
+			Description: "Names of the object store buckets attached to the filesystem.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"encrypted": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"auth_required": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"data_reduction": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"max_files": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+// flattenFilesystem is the reverse of filesystemAttributes, plus the
+// name and uid.
+func flattenFilesystem(fs WekaFilesystemData) map[string]interface{} {
+	obs_names := []string{}
+
+	for _, b := range fs.ObsBuckets {
+		obs_names = append(obs_names, b.Name)
+	}
+
+	return map[string]interface{}{
+		"uid":               fs.UID,
+		"name":              fs.Name,
+		"group_name":        fs.GroupName,
+		"status":            fs.Status,
+		"is_ready":          fs.IsReady,
+		"total_capacity_gb": bytesToGb(int64(fs.AvailableTotal + fs.UsedTotal)),
+		"ssd_capacity_gb":   bytesToGb(int64(fs.AvailableSsd + fs.UsedSsd)),
+		"used_total":        fs.UsedTotal,
+		"free_total":        fs.FreeTotal,
+		"used_ssd":          fs.UsedSsd,
+		"available_ssd":     fs.AvailableSsd,
+		"tiered":            len(fs.ObsBuckets) > 0,
+		"obs_names":         obs_names,
+		"encrypted":         fs.IsEncrypted,
+		"auth_required":     fs.AuthRequired,
+		"data_reduction":    fs.DataReduction,
+		"max_files":         fs.MaxFiles,
+	}
+}
+
+func dataSourceFilesystem() *schema.Resource {
+	s := filesystemAttributes()
+
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"name", "uid"},
+	}
+
+	s["uid"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"name", "uid"},
+	}
+
+	return &schema.Resource{
+		Description: "Reads a filesystem by name or UID, including filesystems not managed by Terraform.",
+		ReadContext: dataSourceFilesystemRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceFilesystemRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	uid := d.Get("uid").(string)
+
+	if uid == "" {
+		var err error
+
+		uid, err = lookupFilesystemUID(c, d.Get("name").(string))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s", uid))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var fs WekaFilesystem
+
+	if err := json.Unmarshal(body, &fs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, v := range flattenFilesystem(fs.Data) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fs.Data.UID)
+
+	return diags
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFilesystemGroupUsage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sums the capacity and usage of every filesystem in a filesystem group. All values are in bytes.",
+		ReadContext: dataSourceFilesystemGroupUsageRead,
+		Schema: map[string]*schema.Schema{
+			"group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filesystem_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ssd_budget": {
+				Description: "SSD capacity allocated to the group's filesystems.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_ssd": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"free_ssd": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"total_budget": {
+				Description: "Total capacity allocated to the group's filesystems.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"free_total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFilesystemGroupUsageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	filesystems, err := getFilesystems(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group_name := d.Get("group_name").(string)
+
+	var count, ssd_budget, used_ssd, free_ssd, total_budget, used_total, free_total int
+
+	for _, fs := range filesystems.Data {
+		if fs.GroupName != group_name {
+			continue
+		}
+
+		count++
+		ssd_budget += fs.SsdBudget
+		used_ssd += fs.UsedSsd
+		free_ssd += fs.FreeSsd
+		total_budget += fs.TotalBudget
+		used_total += fs.UsedTotal
+		free_total += fs.FreeTotal
+	}
+
+	d.Set("filesystem_count", count)
+	d.Set("ssd_budget", ssd_budget)
+	d.Set("used_ssd", used_ssd)
+	d.Set("free_ssd", free_ssd)
+	d.Set("total_budget", total_budget)
+	d.Set("used_total", used_total)
+	d.Set("free_total", free_total)
+
+	d.SetId(group_name)
+
+	return diags
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFilesystems() *schema.Resource {
+	s := filesystemAttributes()
+
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	s["uid"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Description: "Lists the filesystems in Weka, including filesystems not managed by Terraform.",
+		ReadContext: dataSourceFilesystemsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Description:  "Only return filesystems whose name matches this regular expression.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"group_name": {
+				Description: "Only return filesystems in this filesystem group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"names": {
+				Description: "Names of the matching filesystems, in the same order as filesystems.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"filesystems": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: s,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFilesystemsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	filesystems, err := getFilesystems(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var name_regex *regexp.Regexp
+
+	if v := d.Get("name_regex").(string); v != "" {
+		// already checked by StringIsValidRegExp
+		name_regex = regexp.MustCompile(v)
+	}
+
+	group_name := d.Get("group_name").(string)
+	names := []string{}
+	found := make([]map[string]interface{}, 0)
+
+	for _, fs := range filesystems.Data {
+		if name_regex != nil && !name_regex.MatchString(fs.Name) {
+			continue
+		}
+
+		if group_name != "" && fs.GroupName != group_name {
+			continue
+		}
+
+		names = append(names, fs.Name)
+		found = append(found, flattenFilesystem(fs))
+	}
+
+	d.Set("names", names)
+
+	if err := d.Set("filesystems", found); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceHealth() *schema.Resource {
+	return &schema.Resource{
+		Description: "Summarizes the health of the cluster, for use as a precondition before destructive changes.",
+		ReadContext: dataSourceHealthRead,
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Description: "Cluster status, e.g 'OK'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"io_status": {
+				Description: "IO status, e.g 'STARTED'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"healthy": {
+				Description: "True if the cluster is OK, IO is started, nothing is rebuilding and no drives or backends are down.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"rebuilding": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rebuild_progress_percent": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"protection_level": {
+				Description: "Number of failures the cluster can currently tolerate.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"failed_drives": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"failed_backends": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceHealthRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// protectionState lists how much of the data has been hit by how
+	// many failures, the protection level is what's left for the worst
+	// hit data.
+	failures := 0
+	for _, p := range cluster.Data.Rebuild.ProtectionState {
+		if p.Percent > 0 && p.NumFailures > failures {
+			failures = p.NumFailures
+		}
+	}
+
+	protection_level := cluster.Data.StripeProtectionDrives - failures
+	rebuilding := failures > 0 || (cluster.Data.Rebuild.ProgressPercent > 0 && cluster.Data.Rebuild.ProgressPercent < 100)
+	failed_drives := cluster.Data.Drives.Total - cluster.Data.Drives.Active
+	failed_backends := cluster.Data.Hosts.Backends.Total - cluster.Data.Hosts.Backends.Active
+
+	d.Set("status", cluster.Data.Status)
+	d.Set("io_status", cluster.Data.IOStatus)
+	d.Set("rebuilding", rebuilding)
+	d.Set("rebuild_progress_percent", cluster.Data.Rebuild.ProgressPercent)
+	d.Set("protection_level", protection_level)
+	d.Set("failed_drives", failed_drives)
+	d.Set("failed_backends", failed_backends)
+	d.Set("healthy", cluster.Data.Status == "OK" &&
+		cluster.Data.IOStatus == "STARTED" &&
+		!rebuilding &&
+		failed_drives == 0 &&
+		failed_backends == 0)
+
+	d.SetId(cluster.Data.GUID)
+
+	return diags
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceHosts() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the hosts (containers) in the cluster.",
+		ReadContext: dataSourceHostsRead,
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Description:  "Only return hosts in this mode, one of: backend or client.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"backend", "client"}, false),
+			},
+			"hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"container_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"roles": {
+							Description: "Kinds of process the container runs, any of: compute, drives or frontend.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHostsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	containers, err := getContainers(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mode := d.Get("mode").(string)
+	hosts := make([]map[string]interface{}, 0)
+
+	for _, h := range containers.Data {
+		if mode != "" && h.Mode != mode {
+			continue
+		}
+
+		hosts = append(hosts, map[string]interface{}{
+			"uid":            h.UID,
+			"host_id":        h.HostID,
+			"hostname":       h.Hostname,
+			"container_name": h.ContainerName,
+			"ips":            h.Ips,
+			"mode":           h.Mode,
+			"status":         h.Status,
+			"state":          h.State,
+			"roles":          h.roles(),
+		})
+	}
+
+	if err := d.Set("hosts", hosts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
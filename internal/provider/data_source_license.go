@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceLicense() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads the cluster's licensed capacity, how much of it is used and when the license expires. Capacities are in GB, as Weka licenses them.",
+		ReadContext: dataSourceLicenseRead,
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Description: "How the cluster is licensed, e.g 'Unlicensed', 'Classic' or 'PayGo'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"licensed_drive_capacity_gb": {
+				Description: "Raw drive capacity the license allows.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_drive_capacity_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"licensed_usable_capacity_gb": {
+				Description: "Usable capacity, after protection, the license allows.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_usable_capacity_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"licensed_obs_capacity_gb": {
+				Description: "Object store capacity the license allows.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_obs_capacity_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"expires_at": {
+				Description: "When the license expires, in RFC3339 format. Empty if it doesn't expire.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"days_until_expiry": {
+				Description: "Whole days left before the license expires, negative once it has expired. Only meaningful when `expires` is true.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"expires": {
+				Description: "Whether the license has an expiry date at all.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"expired": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaLicenseCapacity struct {
+	DriveCapacityGB  int64 `json:"drive_capacity_gb"`
+	UsableCapacityGB int64 `json:"usable_capacity_gb"`
+	OBSCapacityGB    int64 `json:"obs_capacity_gb"`
+}
+
+type WekaLicense struct {
+	Data struct {
+		Mode      string              `json:"mode"`
+		Usage     WekaLicenseCapacity `json:"usage"`
+		Limits    WekaLicenseCapacity `json:"limits"`
+		ExpiresAt string              `json:"expiry_date"`
+	} `json:"data"`
+}
+
+func dataSourceLicenseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("license")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaLicense
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("mode", parsed.Data.Mode)
+	d.Set("licensed_drive_capacity_gb", parsed.Data.Limits.DriveCapacityGB)
+	d.Set("used_drive_capacity_gb", parsed.Data.Usage.DriveCapacityGB)
+	d.Set("licensed_usable_capacity_gb", parsed.Data.Limits.UsableCapacityGB)
+	d.Set("used_usable_capacity_gb", parsed.Data.Usage.UsableCapacityGB)
+	d.Set("licensed_obs_capacity_gb", parsed.Data.Limits.OBSCapacityGB)
+	d.Set("used_obs_capacity_gb", parsed.Data.Usage.OBSCapacityGB)
+	d.Set("expires_at", parsed.Data.ExpiresAt)
+
+	days_until_expiry := 0
+	expires := parsed.Data.ExpiresAt != ""
+	expired := false
+
+	if expires {
+		expires_at, err := time.Parse(time.RFC3339, parsed.Data.ExpiresAt)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		days_until_expiry = int(math.Floor(time.Until(expires_at).Hours() / 24))
+		expired = time.Now().After(expires_at)
+	}
+
+	d.Set("days_until_expiry", days_until_expiry)
+	d.Set("expires", expires)
+	d.Set("expired", expired)
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNetworkDevices() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the network devices discovered on each backend host, to choose which devices Weka uses for data networking.",
+		ReadContext: dataSourceNetworkDevicesRead,
+		Schema: map[string]*schema.Schema{
+			"container_uid": {
+				Description: "Only return devices on this container's host.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Description: "Device name on the host, e.g 'eth1'.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"pci_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"link_status": {
+							Description: "Link state reported by the host, e.g 'UP' or 'DOWN'.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"speed_mbps": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WekaNetworkDevices struct {
+	Data []struct {
+		Name       string `json:"name"`
+		PCIAddress string `json:"pci_address"`
+		MACAddress string `json:"mac_address"`
+		LinkStatus string `json:"link_status"`
+		Speed      int    `json:"speed"`
+	} `json:"data"`
+}
+
+func getNetworkDevices(c *WekaClient, uid string) (*WekaNetworkDevices, error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("containers/%s/netDevices", uid))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var devices WekaNetworkDevices
+
+	if err := json.Unmarshal(body, &devices); err != nil {
+		return nil, err
+	}
+
+	return &devices, nil
+}
+
+func dataSourceNetworkDevicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	containers, err := getContainers(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	uid := d.Get("container_uid").(string)
+	devices := make([]map[string]interface{}, 0)
+
+	for _, h := range containers.Data {
+		if uid != "" && h.UID != uid {
+			continue
+		}
+
+		// clients don't take part in data networking.
+		if uid == "" && h.Mode != "backend" {
+			continue
+		}
+
+		netdevs, err := getNetworkDevices(c, h.UID)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, n := range netdevs.Data {
+			devices = append(devices, map[string]interface{}{
+				"container_uid": h.UID,
+				"hostname":      h.Hostname,
+				"name":          n.Name,
+				"pci_address":   n.PCIAddress,
+				"mac_address":   n.MACAddress,
+				"link_status":   n.LinkStatus,
+				"speed_mbps":    n.Speed,
+			})
+		}
+	}
+
+	if err := d.Set("devices", devices); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNFSClientGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the NFS client groups configured in Weka along with their rules.",
+		ReadContext: dataSourceNFSClientGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Only return the client group with this name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"client_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"ip_rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WekaNFSClientGroups struct {
+	Data []WekaNFSClientGroupData `json:"data"`
+}
+
+func dataSourceNFSClientGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("nfs/clientGroups")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaNFSClientGroups
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	groups := make([]map[string]interface{}, 0)
+
+	for _, g := range parsed.Data {
+		if name != "" && g.Name != name {
+			continue
+		}
+
+		dns_rules := []string{}
+		ip_rules := []string{}
+
+		for _, r := range g.Rules {
+			switch r.Type {
+			case "DNS":
+				dns_rules = append(dns_rules, r.Rule)
+			case "IP":
+				ip_rules = append(ip_rules, r.Rule)
+			}
+		}
+
+		groups = append(groups, map[string]interface{}{
+			"uid":       g.UID,
+			"name":      g.Name,
+			"dns_rules": dns_rules,
+			"ip_rules":  ip_rules,
+		})
+	}
+
+	if err := d.Set("client_groups", groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
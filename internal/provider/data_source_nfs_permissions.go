@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNFSPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the NFS exports (permissions) configured in Weka, optionally filtered by filesystem or client group.",
+		ReadContext: dataSourceNFSPermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"filesystem": {
+				Description: "Only return permissions for this filesystem name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"client_group": {
+				Description: "Only return permissions for this client group name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filesystem": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"squash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"anon_uid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"anon_gid": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"obs_direct": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"manage_gids": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"privileged_port": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supported_versions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WekaNFSPermissions struct {
+	Data []struct {
+		UID               string   `json:"uid"`
+		Filesystem        string   `json:"filesystem"`
+		Group             string   `json:"group"`
+		Path              string   `json:"path"`
+		PermissionType    string   `json:"permission_type"`
+		RootSquashing     string   `json:"squash"`
+		AnonUID           int      `json:"anon_uid"`
+		AnonGID           int      `json:"anon_gid"`
+		ObsDirect         bool     `json:"obs_direct"`
+		ManageGids        bool     `json:"manage_gids"`
+		PrivilegedPort    bool     `json:"privileged_port"`
+		SupportedVersions []string `json:"supported_versions"`
+	} `json:"data"`
+}
+
+func dataSourceNFSPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("nfs/permissions")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaNFSPermissions
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	filesystem := d.Get("filesystem").(string)
+	client_group := d.Get("client_group").(string)
+
+	permissions := make([]map[string]interface{}, 0)
+
+	for _, p := range parsed.Data {
+		if filesystem != "" && p.Filesystem != filesystem {
+			continue
+		}
+
+		if client_group != "" && p.Group != client_group {
+			continue
+		}
+
+		permissions = append(permissions, map[string]interface{}{
+			"uid":                p.UID,
+			"filesystem":         p.Filesystem,
+			"client_group":       p.Group,
+			"path":               p.Path,
+			"access_type":        p.PermissionType,
+			"squash":             p.RootSquashing,
+			"anon_uid":           p.AnonUID,
+			"anon_gid":           p.AnonGID,
+			"obs_direct":         p.ObsDirect,
+			"manage_gids":        p.ManageGids,
+			"privileged_port":    p.PrivilegedPort,
+			"supported_versions": p.SupportedVersions,
+		})
+	}
+
+	if err := d.Set("permissions", permissions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOBSBuckets() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the object store buckets attached to filesystems for tiering, one entry per filesystem and bucket.",
+		ReadContext: dataSourceOBSBucketsRead,
+		Schema: map[string]*schema.Schema{
+			"filesystem_name": {
+				Description: "Only return buckets attached to this filesystem.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"buckets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"filesystem_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filesystem_uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Description: "Name of the object store bucket, as given to weka_obs_store.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"obs_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mode": {
+							Description: "How the bucket is attached, e.g 'writable' or 'remote'.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOBSBucketsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	filesystems, err := getFilesystems(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filesystem_name := d.Get("filesystem_name").(string)
+	buckets := make([]map[string]interface{}, 0)
+
+	for _, fs := range filesystems.Data {
+		if filesystem_name != "" && fs.Name != filesystem_name {
+			continue
+		}
+
+		for _, b := range fs.ObsBuckets {
+			buckets = append(buckets, map[string]interface{}{
+				"filesystem_name": fs.Name,
+				"filesystem_uid":  fs.UID,
+				"name":            b.Name,
+				"uid":             b.UID,
+				"obs_id":          b.ObsID,
+				"mode":            b.Mode,
+				"state":           b.State,
+			})
+		}
+	}
+
+	if err := d.Set("buckets", buckets); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceOBSStores() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the object store buckets configured in Weka, including those not managed by Terraform.",
+		ReadContext: dataSourceOBSStoresRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Only return the object store bucket with this name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"site": {
+				Description:  "Only return object store buckets at this site, one of: local or remote.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"local", "remote"}, false),
+			},
+			"stores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"site": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"bucket": {
+							Description: "Name of the bucket on the object store.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOBSStoresRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("objectStoreBuckets")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaOBSStores
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	site := d.Get("site").(string)
+	stores := make([]map[string]interface{}, 0)
+
+	for _, s := range parsed.Data {
+		if name != "" && s.Name != name {
+			continue
+		}
+
+		if site != "" && s.Site != site {
+			continue
+		}
+
+		// weka returns the port as a string
+		var port int
+		fmt.Sscanf(s.Port, "%d", &port)
+
+		stores = append(stores, map[string]interface{}{
+			"uid":      s.UID,
+			"name":     s.Name,
+			"site":     s.Site,
+			"hostname": s.Hostname,
+			"port":     port,
+			"bucket":   s.Bucket,
+			"protocol": s.Protocol,
+			"region":   s.Region,
+			"status":   s.Status,
+		})
+	}
+
+	if err := d.Set("stores", stores); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceProcesses() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the Weka processes running in the cluster.",
+		ReadContext: dataSourceProcessesRead,
+		Schema: map[string]*schema.Schema{
+			"container_uid": {
+				Description: "Only return processes in this container.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"processes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"container_uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"roles": {
+							Description: "Roles of the process, e.g COMPUTE, DRIVES, FRONTEND or MANAGEMENT.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"core_id": {
+							Description: "Core the process is pinned to, -1 if it isn't.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"slot": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WekaProcesses struct {
+	Data []struct {
+		UID          string   `json:"uid"`
+		ContainerUID string   `json:"host_uid"`
+		Hostname     string   `json:"hostname"`
+		Roles        []string `json:"roles"`
+		Status       string   `json:"status"`
+		Mode         string   `json:"mode"`
+		CoreID       int      `json:"core_id"`
+		Slot         int      `json:"slot"`
+	} `json:"data"`
+}
+
+func dataSourceProcessesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("processes")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaProcesses
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	container_uid := d.Get("container_uid").(string)
+	processes := make([]map[string]interface{}, 0)
+
+	for _, p := range parsed.Data {
+		if container_uid != "" && p.ContainerUID != container_uid {
+			continue
+		}
+
+		processes = append(processes, map[string]interface{}{
+			"uid":           p.UID,
+			"container_uid": p.ContainerUID,
+			"hostname":      p.Hostname,
+			"roles":         p.Roles,
+			"status":        p.Status,
+			"mode":          p.Mode,
+			"core_id":       p.CoreID,
+			"slot":          p.Slot,
+		})
+	}
+
+	if err := d.Set("processes", processes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
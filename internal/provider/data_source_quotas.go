@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceQuotas() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the directory quotas of a filesystem along with their usage. Byte values of 0 mean no limit is set.",
+		ReadContext: dataSourceQuotasRead,
+		Schema: map[string]*schema.Schema{
+			"fs_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inode_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hard_limit_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"soft_limit_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"grace_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"used_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WekaQuotas struct {
+	Data []struct {
+		InodeID        string `json:"inode_id"`
+		Path           string `json:"path"`
+		Owner          string `json:"owner"`
+		Status         string `json:"status"`
+		HardLimitBytes int64  `json:"hard_limit_bytes"`
+		SoftLimitBytes int64  `json:"soft_limit_bytes"`
+		GraceSeconds   int    `json:"grace_seconds"`
+		UsedBytes      int64  `json:"used_bytes"`
+	} `json:"data"`
+}
+
+func dataSourceQuotasRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	fs_uid := d.Get("fs_uid").(string)
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s/quota", fs_uid))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaQuotas
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	quotas := make([]map[string]interface{}, 0, len(parsed.Data))
+
+	for _, q := range parsed.Data {
+		quotas = append(quotas, map[string]interface{}{
+			"inode_id":         q.InodeID,
+			"path":             q.Path,
+			"owner":            q.Owner,
+			"status":           q.Status,
+			"hard_limit_bytes": q.HardLimitBytes,
+			"soft_limit_bytes": q.SoftLimitBytes,
+			"grace_seconds":    q.GraceSeconds,
+			"used_bytes":       q.UsedBytes,
+		})
+	}
+
+	if err := d.Set("quotas", quotas); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fs_uid)
+
+	return diags
+}
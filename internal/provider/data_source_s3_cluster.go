@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceS3Cluster() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads the status of the S3 service.",
+		ReadContext: dataSourceS3ClusterRead,
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Description: "Whether the S3 service is running.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Status of the S3 service as reported by Weka.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_fs_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_fs_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"all_hosts": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"hosts": {
+				Description: "IDs of the containers running the S3 service.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceS3ClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getS3Cluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("active", cluster.Data.Active)
+	d.Set("status", cluster.Data.Status)
+	d.Set("port", cluster.Data.Port)
+	d.Set("domain", cluster.Data.Domain)
+	d.Set("default_fs_name", cluster.Data.Filesystem)
+	d.Set("config_fs_name", cluster.Data.ConfigFsName)
+	d.Set("all_hosts", cluster.Data.AllHosts)
+	d.Set("hosts", cluster.Data.Hosts)
+
+	d.SetId("s3")
+
+	return diags
+}
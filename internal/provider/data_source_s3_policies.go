@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceS3Policies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the S3 policies defined in Weka along with their policy documents.",
+		ReadContext: dataSourceS3PoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Only return the policy with this name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_file_content": {
+							Description: "JSON string containing the S3 policy document.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WekaS3Policies struct {
+	Data struct {
+		Policies []struct {
+			Name string `json:"name"`
+		} `json:"policies"`
+	} `json:"data"`
+}
+
+type WekaS3Policy struct {
+	Data struct {
+		Policy struct {
+			Name    string      `json:"name"`
+			Content interface{} `json:"content"`
+		} `json:"policy"`
+	} `json:"data"`
+}
+
+func dataSourceS3PoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("s3/policies")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaS3Policies
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	policies := make([]map[string]interface{}, 0)
+
+	for _, p := range parsed.Data.Policies {
+		if name != "" && p.Name != name {
+			continue
+		}
+
+		// the list doesn't include the policy documents, so each
+		// one has to be fetched separately.
+		policyDocument, err := getS3PolicyDocument(c, p.Name)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		policies = append(policies, map[string]interface{}{
+			"name":                p.Name,
+			"policy_file_content": policyDocument,
+		})
+	}
+
+	if err := d.Set("policies", policies); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+func getS3PolicyDocument(c *WekaClient, name string) (string, error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("s3/policies/%s", name))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return "", err
+	}
+
+	var policy WekaS3Policy
+
+	if err := json.Unmarshal(body, &policy); err != nil {
+		return "", err
+	}
+
+	policyDocument, err := json.Marshal(policy.Data.Policy.Content)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(policyDocument), nil
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// weka only implements a subset of the IAM policy grammar, so this only
+// builds documents from statements with a sid, effect, actions and
+// resources.
+func dataSourceS3PolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		Description: "Builds an S3 policy document in the form understood by Weka, for use with weka_s3_policy or the policy_json of weka_s3_bucket.",
+		ReadContext: dataSourceS3PolicyDocumentRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Description:  "Policy language version, must be 2012-10-17.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "2012-10-17",
+				ValidateFunc: validation.StringInSlice([]string{"2012-10-17"}, false),
+			},
+			"statement": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"effect": {
+							Description:  "Must be one of: Allow or Deny.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Allow",
+							ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, false),
+						},
+						"actions": {
+							Description: "S3 actions, for example s3:GetObject or s3:*.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(s3ActionRegexp, "actions must be of the form s3:<action>"),
+							},
+						},
+						"resources": {
+							Description: "Resource ARNs, for example arn:aws:s3:::bucket/*.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(s3ResourceRegexp, "resources must be of the form arn:aws:s3:::<bucket>[/<key>]"),
+							},
+						},
+					},
+				},
+			},
+			"json": {
+				Description: "The assembled policy document.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+var s3ActionRegexp = regexp.MustCompile(`^s3:[A-Za-z*]+$`)
+var s3ResourceRegexp = regexp.MustCompile(`^arn:aws:s3:::[^/\s]+(/.*)?$`)
+
+type WekaS3PolicyStatement struct {
+	Sid      string   `json:"Sid,omitempty"`
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource []string `json:"Resource"`
+}
+
+type WekaS3PolicyDocument struct {
+	Version   string                  `json:"Version"`
+	Statement []WekaS3PolicyStatement `json:"Statement"`
+}
+
+func dataSourceS3PolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := WekaS3PolicyDocument{
+		Version:   d.Get("version").(string),
+		Statement: []WekaS3PolicyStatement{},
+	}
+
+	for _, s := range d.Get("statement").([]interface{}) {
+		statement := s.(map[string]interface{})
+
+		doc.Statement = append(doc.Statement, WekaS3PolicyStatement{
+			Sid:      statement["sid"].(string),
+			Effect:   statement["effect"].(string),
+			Action:   expandSortedStringSet(statement["actions"].(*schema.Set)),
+			Resource: expandSortedStringSet(statement["resources"].(*schema.Set)),
+		})
+	}
+
+	policyDocument, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("json", string(policyDocument))
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
+
+// sets come back in hash order, sort them so the document is stable.
+func expandSortedStringSet(s *schema.Set) []string {
+	values := make([]string, 0, s.Len())
+
+	for _, v := range s.List() {
+		values = append(values, v.(string))
+	}
+
+	sort.Strings(values)
+
+	return values
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSMBCluster() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads the status of the SMB cluster.",
+		ReadContext: dataSourceSMBClusterRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_netbios_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"active_directory_joined": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Description: "Status of the SMB service as reported by Weka.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"encryption": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_fs_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"smbw_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"hosts": {
+				Description: "Hosts participating in the SMB cluster.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ips": {
+				Description: "Floating IPs of the SMB cluster.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSMBClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getSMBCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("name", cluster.Data.ClusterName)
+	d.Set("domain", cluster.Data.Domain)
+	d.Set("domain_netbios_name", cluster.Data.DomainNetbiosName)
+	d.Set("active_directory_joined", cluster.Data.ActiveDirectory)
+	d.Set("status", cluster.Data.Status)
+	d.Set("encryption", cluster.Data.Encryption)
+	d.Set("config_fs_name", cluster.Data.SmbConfFsName)
+	d.Set("smbw_enabled", cluster.Data.SmbwEnabled)
+	d.Set("hosts", cluster.Data.Hosts)
+	d.Set("ips", cluster.Data.Ips)
+
+	d.SetId(cluster.Data.ClusterName)
+
+	return diags
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists snapshots in Weka, optionally filtered by filesystem or name prefix.",
+		ReadContext: dataSourceSnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"fs_uid": {
+				Description: "Only return snapshots of this filesystem.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name_prefix": {
+				Description: "Only return snapshots whose name starts with this prefix.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fs_uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filesystem": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_point": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_writable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type WekaSnapshots struct {
+	Data []WekaSnapshotData `json:"data"`
+}
+
+func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("snapshots")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaSnapshots
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	fs_uid := d.Get("fs_uid").(string)
+	name_prefix := d.Get("name_prefix").(string)
+
+	snapshots := make([]map[string]interface{}, 0)
+
+	for _, s := range parsed.Data {
+		if fs_uid != "" && s.FsUID != fs_uid {
+			continue
+		}
+
+		if !strings.HasPrefix(s.Name, name_prefix) {
+			continue
+		}
+
+		snapshots = append(snapshots, map[string]interface{}{
+			"uid":           s.UID,
+			"name":          s.Name,
+			"fs_uid":        s.FsUID,
+			"filesystem":    s.Filesystem,
+			"access_point":  s.AccessPoint,
+			"locator":       s.Locator,
+			"is_writable":   s.IsWritable,
+			"creation_time": s.CreationTime,
+		})
+	}
+
+	if err := d.Set("snapshots", snapshots); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
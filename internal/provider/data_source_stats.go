@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceStats() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reads realtime performance statistics for the cluster, or a single filesystem. The values are a snapshot taken when the data source is read.",
+		ReadContext: dataSourceStatsRead,
+		Schema: map[string]*schema.Schema{
+			"fs_uid": {
+				Description: "Only report on this filesystem, otherwise the whole cluster is reported on.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ops_per_sec": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"read_ops_per_sec": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"write_ops_per_sec": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"read_bytes_per_sec": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"write_bytes_per_sec": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"read_latency_usecs": {
+				Description: "Average read latency in microseconds.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"write_latency_usecs": {
+				Description: "Average write latency in microseconds.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// weka reports realtime stats per process.
+type WekaRealtimeStats struct {
+	Data []struct {
+		Ops          float64 `json:"ops"`
+		Reads        float64 `json:"reads"`
+		Writes       float64 `json:"writes"`
+		ReadBytes    float64 `json:"read_bytes"`
+		WriteBytes   float64 `json:"write_bytes"`
+		ReadLatency  float64 `json:"read_latency"`
+		WriteLatency float64 `json:"write_latency"`
+	} `json:"data"`
+}
+
+func dataSourceStatsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	endpoint := c.makeRestEndpointURL("stats/realtime")
+
+	if v := d.Get("fs_uid").(string); v != "" {
+		query := endpoint.Query()
+		query.Set("fs_uid", v)
+		endpoint.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequest("GET", endpoint.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaRealtimeStats
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ops, reads, writes, read_bytes, write_bytes, read_latency, write_latency float64
+
+	for _, s := range parsed.Data {
+		ops += s.Ops
+		reads += s.Reads
+		writes += s.Writes
+		read_bytes += s.ReadBytes
+		write_bytes += s.WriteBytes
+
+		// weight the latencies by the number of operations so
+		// idle processes don't drag the average down.
+		read_latency += s.ReadLatency * s.Reads
+		write_latency += s.WriteLatency * s.Writes
+	}
+
+	if reads > 0 {
+		read_latency /= reads
+	}
+
+	if writes > 0 {
+		write_latency /= writes
+	}
+
+	d.Set("ops_per_sec", ops)
+	d.Set("read_ops_per_sec", reads)
+	d.Set("write_ops_per_sec", writes)
+	d.Set("read_bytes_per_sec", read_bytes)
+	d.Set("write_bytes_per_sec", write_bytes)
+	d.Set("read_latency_usecs", read_latency)
+	d.Set("write_latency_usecs", write_latency)
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up a single user by username.",
+		ReadContext: dataSourceUserRead,
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": {
+				Description: "Where the user is defined, Internal or LDAP.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"posix_uid": {
+				Description: "Only available on versions of Weka that return POSIX IDs.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"posix_gid": {
+				Description: "Only available on versions of Weka that return POSIX IDs.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	parsed, err := getUsers(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	username := d.Get("username").(string)
+
+	for _, u := range parsed.Data {
+		if u.Username != username {
+			continue
+		}
+
+		d.Set("uid", u.UID)
+		d.Set("role", normalizeUserRole(u.Role))
+		d.Set("source", u.Source)
+
+		if u.PosixUID != nil && u.PosixGID != nil {
+			d.Set("posix_uid", *u.PosixUID)
+			d.Set("posix_gid", *u.PosixGID)
+		} else {
+			posix_uid, posix_gid, ok, err := getUserPosixIDs(c, u.UID)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				d.Set("posix_uid", posix_uid)
+				d.Set("posix_gid", posix_gid)
+			}
+		}
+
+		d.SetId(u.UID)
+
+		return diags
+	}
+
+	return diag.FromErr(fmt.Errorf("user %s not found", username))
+}
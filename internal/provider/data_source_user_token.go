@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserToken() *schema.Resource {
+	return &schema.Resource{
+		Description: "Logs in as a user and returns their API tokens, for bootstrapping automation such as client mounts or the CSI driver. A new token is generated on every read, and the tokens are stored in state.",
+		ReadContext: dataSourceUserTokenRead,
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"org": {
+				Description: "Organization of the user, defaults to the provider's org.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"access_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"refresh_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_in": {
+				Description: "Lifetime of the access token in seconds.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceUserTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	org := d.Get("org").(string)
+
+	if org == "" {
+		org = c.getOrg()
+	}
+
+	authBody, err := json.Marshal(map[string]string{
+		"username": d.Get("username").(string),
+		"password": d.Get("password").(string),
+		"org":      org,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("login")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(authBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var wr WekaAuthResponse
+
+	if err := json.Unmarshal(body, &wr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("access_token", wr.Data.AccessToken)
+	d.Set("refresh_token", wr.Data.RefreshToken)
+	d.Set("token_type", wr.Data.TokenType)
+	d.Set("expires_in", wr.Data.ExpiresIn)
+
+	d.SetId(d.Get("username").(string))
+
+	return diags
+}
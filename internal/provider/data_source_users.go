@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the users in the organization.",
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Description: "Only return users with this role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Description: "Where the user is defined, Internal or LDAP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	parsed, err := getUsers(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	role := d.Get("role").(string)
+	users := make([]map[string]interface{}, 0)
+
+	for _, u := range parsed.Data {
+		if role != "" && !strings.EqualFold(u.Role, role) {
+			continue
+		}
+
+		users = append(users, map[string]interface{}{
+			"uid":      u.UID,
+			"username": u.Username,
+			"role":     normalizeUserRole(u.Role),
+			"source":   u.Source,
+		})
+	}
+
+	if err := d.Set("users", users); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
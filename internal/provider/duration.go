@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// duration units understood by parseDuration, in seconds. time.Duration
+// doesn't know about days, which is the unit retention is usually
+// thought of in.
+var durationUnits = map[string]int64{
+	"":  1,
+	"s": 1,
+	"m": 60,
+	"h": 60 * 60,
+	"d": 24 * 60 * 60,
+	"w": 7 * 24 * 60 * 60,
+}
+
+var durationRegexp = regexp.MustCompile(`([0-9]+)\s*([a-zA-Z]*)`)
+
+// parseDuration converts a duration such as "4h", "1d12h" or "90m" into
+// a number of seconds. A bare number is taken as seconds.
+func parseDuration(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+
+	if s == "" || durationRegexp.ReplaceAllString(s, "") != "" {
+		return 0, fmt.Errorf("cannot parse duration %q, expected numbers followed by a unit such as s, m, h, d or w", s)
+	}
+
+	var seconds int64
+
+	for _, m := range durationRegexp.FindAllStringSubmatch(s, -1) {
+		multiplier, ok := durationUnits[strings.ToLower(m[2])]
+
+		if !ok {
+			return 0, fmt.Errorf("unknown duration unit %q in %q", m[2], s)
+		}
+
+		n, err := strconv.ParseInt(m[1], 10, 64)
+
+		if err != nil {
+			return 0, err
+		}
+
+		seconds += n * multiplier
+	}
+
+	return seconds, nil
+}
+
+// formatDuration is the reverse of parseDuration, using the largest
+// unit that represents the value exactly.
+func formatDuration(seconds int64) string {
+	for _, u := range []string{"w", "d", "h", "m"} {
+		m := durationUnits[u]
+
+		if seconds != 0 && seconds%m == 0 {
+			return fmt.Sprintf("%d%s", seconds/m, u)
+		}
+	}
+
+	return fmt.Sprintf("%ds", seconds)
+}
+
+func validateDuration(val any, key string) (warns []string, errs []error) {
+	if _, err := parseDuration(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q: %s", key, err))
+	}
+
+	return
+}
+
+// normalizeDuration is a StateFunc storing durations in the units
+// formatDuration would use, so "24h" and "1d" are stored the same way.
+func normalizeDuration(val interface{}) string {
+	seconds, err := parseDuration(val.(string))
+
+	if err != nil {
+		return val.(string)
+	}
+
+	return formatDuration(seconds)
+}
+
+// durations are equal if they're the same number of seconds, however
+// they're written.
+func durationDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, err := parseDuration(old)
+
+	if err != nil {
+		return false
+	}
+
+	n, err := parseDuration(new)
+
+	if err != nil {
+		return false
+	}
+
+	return o == n
+}
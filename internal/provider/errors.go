@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// kinds of error weka commonly returns that the user can do something
+// about, used as the keys of the attribute paths given to wekaDiags.
+const (
+	wekaErrCapacity   = "capacity"
+	wekaErrNameExists = "name_exists"
+	wekaErrBusy       = "busy"
+)
+
+type wekaErrorKind struct {
+	kind    string
+	codes   []string
+	reasons []string
+	summary string
+	hint    string
+}
+
+// weka's error codes aren't documented, so recognise them from the
+// error code, or failing that the reason, but never the free text
+// message, which mentions all sorts of things in passing.
+var wekaErrorKinds = []wekaErrorKind{
+	{
+		kind:    wekaErrCapacity,
+		codes:   []string{"INSUFFICIENT_CAPACITY", "NOT_ENOUGH_SPACE", "OUT_OF_SPACE"},
+		reasons: []string{"not enough capacity", "not enough space", "insufficient capacity", "insufficient space", "out of space"},
+		summary: "Not enough capacity",
+		hint:    "Reduce the requested capacity or free up capacity on the cluster, the weka_capacity data source shows how much is unprovisioned.",
+	},
+	{
+		kind:    wekaErrNameExists,
+		codes:   []string{"ALREADY_EXISTS", "NAME_ALREADY_EXISTS", "DUPLICATE_NAME"},
+		reasons: []string{"already exists", "name is taken"},
+		summary: "Name already in use",
+		hint:    "Choose another name, or bring the existing object under Terraform with terraform import.",
+	},
+	{
+		kind:    wekaErrBusy,
+		codes:   []string{"BUSY", "RESOURCE_BUSY", "OPERATION_IN_PROGRESS", "TRY_AGAIN"},
+		reasons: []string{"is busy", "try again"},
+		summary: "Object is busy",
+		hint:    "Weka is still working on this object, wait for the operation in progress to finish and apply again.",
+	},
+}
+
+// kind returns which of wekaErrorKinds the error is, or nil if it isn't
+// one of them.
+func (e *WekaAPIError) kind() *wekaErrorKind {
+	reason := strings.ToLower(e.Reason)
+
+	for i, k := range wekaErrorKinds {
+		for _, c := range k.codes {
+			if strings.EqualFold(e.Code, c) {
+				return &wekaErrorKinds[i]
+			}
+		}
+
+		for _, r := range k.reasons {
+			if reason != "" && strings.Contains(reason, r) {
+				return &wekaErrorKinds[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// wekaDiags converts an error into diagnostics. Errors weka commonly
+// returns get a summary and a hint about what to do, and point at the
+// attribute given for that kind of error, anything else is
+// returned as is.
+func wekaDiags(err error, paths map[string]string) diag.Diagnostics {
+	var wae *WekaAPIError
+
+	if !errors.As(err, &wae) {
+		return diag.FromErr(err)
+	}
+
+	kind := wae.kind()
+
+	if kind == nil {
+		return diag.FromErr(err)
+	}
+
+	d := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  kind.summary,
+		Detail:   fmt.Sprintf("%s\n\n%s", err, kind.hint),
+	}
+
+	if attr, ok := paths[kind.kind]; ok {
+		d.AttributePath = cty.GetAttrPath(attr)
+	}
+
+	return diag.Diagnostics{d}
+}
+
+// isBusyError returns true if err is weka saying the object is busy,
+// which usually clears up by itself.
+func isBusyError(err error) bool {
+	var wae *WekaAPIError
+
+	if !errors.As(err, &wae) {
+		return false
+	}
+
+	kind := wae.kind()
+
+	return kind != nil && kind.kind == wekaErrBusy
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// weka calls them hosts in older releases and containers in newer ones,
+// the API uses containers.
+type WekaContainerData struct {
+	UID                    string   `json:"uid"`
+	HostID                 string   `json:"host_id"`
+	Hostname               string   `json:"hostname"`
+	ContainerName          string   `json:"container_name"`
+	Ips                    []string `json:"ips"`
+	Mode                   string   `json:"mode"`
+	Status                 string   `json:"status"`
+	State                  string   `json:"state"`
+	StartTime              string   `json:"start_time"`
+	Cores                  int      `json:"cores"`
+	FrontendDedicatedCores int      `json:"frontend_dedicated_cores"`
+	DrivesDedicatedCores   int      `json:"drives_dedicated_cores"`
+	ComputeDedicatedCores  int      `json:"compute_dedicated_cores"`
+	Memory                 int64    `json:"memory"`
+}
+
+type WekaContainer struct {
+	Data WekaContainerData `json:"data"`
+}
+
+type WekaContainers struct {
+	Data []WekaContainerData `json:"data"`
+}
+
+// roles returns which kinds of process the container runs.
+func (c *WekaContainerData) roles() []string {
+	roles := []string{}
+
+	if c.ComputeDedicatedCores > 0 {
+		roles = append(roles, "compute")
+	}
+
+	if c.DrivesDedicatedCores > 0 {
+		roles = append(roles, "drives")
+	}
+
+	if c.FrontendDedicatedCores > 0 {
+		roles = append(roles, "frontend")
+	}
+
+	return roles
+}
+
+func getContainers(c *WekaClient) (*WekaContainers, error) {
+	url := c.makeRestEndpointURL("containers")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var containers WekaContainers
+
+	if err := json.Unmarshal(body, &containers); err != nil {
+		return nil, err
+	}
+
+	return &containers, nil
+}
+
+func getContainer(c *WekaClient, uid string) (*WekaContainer, error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("containers/%s", uid))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var container WekaContainer
+
+	if err := json.Unmarshal(body, &container); err != nil {
+		return nil, err
+	}
+
+	return &container, nil
+}
+
+// setContainerActive activates or deactivates a container, weka drains
+// a container's data onto the rest of the cluster when deactivating it.
+func setContainerActive(c *WekaClient, uid string, active bool) error {
+	action := "deactivate"
+
+	if active {
+		action = "activate"
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"container_uids": []string{uid},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("containers/%s", action))
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(body))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+func waitForContainerState(ctx context.Context, c *WekaClient, uid string, state string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		container, err := getContainer(c, uid)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if container.Data.State != state {
+			return resource.RetryableError(fmt.Errorf("container %s is %s, waiting for %s", uid, container.Data.State, state))
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type WekaNFSClientGroupData struct {
+	UID   string `json:"uid"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Rules []struct {
+		UID  string `json:"uid"`
+		Type string `json:"type"`
+		Rule string `json:"rule"`
+	} `json:"rules"`
+}
+
+type WekaNFSClientGroup struct {
+	Data WekaNFSClientGroupData `json:"data"`
+}
+
+func getNFSClientGroup(c *WekaClient, uid string) (*WekaNFSClientGroup, error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("nfs/clientGroups/%s", uid))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var group WekaNFSClientGroup
+
+	if err := json.Unmarshal(body, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
+
+// hasRule returns true if the group has a rule of the given type, the
+// type is either "DNS" or "IP"
+func (g *WekaNFSClientGroup) hasRule(ruleType string, rule string) bool {
+	for _, r := range g.Data.Rules {
+		if r.Type == ruleType && r.Rule == rule {
+			return true
+		}
+	}
+
+	return false
+}
+
+// the rule body is keyed by type, e.g {"dns": "host.example.com"} or
+// {"ip": "10.0.0.0/255.255.255.0"}
+func modifyNFSClientGroupRule(c *WekaClient, method string, uid string, ruleKey string, rule string) error {
+	ruleBody, err := json.Marshal(map[string]interface{}{
+		ruleKey: rule,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("nfs/clientGroups/%s/rules", uid))
+	req, err := http.NewRequest(method, url.String(), bytes.NewBuffer(ruleBody))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+type WekaInterfaceGroupData struct {
+	UID         string   `json:"uid"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	SubnetMask  string   `json:"subnet_mask"`
+	Gateway     string   `json:"gateway"`
+	AllowManage bool     `json:"allow_manage_gids"`
+	Status      string   `json:"status"`
+	Ips         []string `json:"ips"`
+	Ports       []struct {
+		HostUID string `json:"host_uid"`
+		Port    string `json:"port"`
+		Status  string `json:"status"`
+	} `json:"ports"`
+}
+
+type WekaInterfaceGroup struct {
+	Data WekaInterfaceGroupData `json:"data"`
+}
+
+func getInterfaceGroup(c *WekaClient, uid string) (*WekaInterfaceGroup, error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("interfaceGroups/%s", uid))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var group WekaInterfaceGroup
+
+	if err := json.Unmarshal(body, &group); err != nil {
+		return nil, err
+	}
+
+	return &group, nil
+}
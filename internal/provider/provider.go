@@ -5,13 +5,14 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"io/ioutil"
 	"log"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"path"
 	"strings"
@@ -71,15 +72,78 @@ func New(version string) func() *schema.Provider {
 				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"weka_kms":              resourceKMS(),
-				"weka_filesystem":       resourceFilesystem(),
-				"weka_filesystem_group": resourceFilesystemGroup(),
-				"weka_user":             resourceUser(),
-				"weka_s3_policy":        resourceS3Policy(),
-				"weka_user_s3_policy":   resourceUserPolicy(),
-				"weka_s3_bucket":        resourceS3Bucket(),
+				"weka_kms":                      resourceKMS(),
+				"weka_kms_rewrap":               resourceKMSRewrap(),
+				"weka_audit_webhook":            resourceAuditWebhook(),
+				"weka_alert_definition":         resourceAlertDefinition(),
+				"weka_syslog":                   resourceSyslog(),
+				"weka_smtp":                     resourceSMTP(),
+				"weka_diagnostics":              resourceDiagnostics(),
+				"weka_host":                     resourceHost(),
+				"weka_client":                   resourceClient(),
+				"weka_maintenance_mode":         resourceMaintenanceMode(),
+				"weka_cluster_upgrade":          resourceClusterUpgrade(),
+				"weka_default_net":              resourceDefaultNet(),
+				"weka_time":                     resourceTime(),
+				"weka_container":                resourceContainer(),
+				"weka_hot_spare":                resourceHotSpare(),
+				"weka_rebuild_settings":         resourceRebuildSettings(),
+				"weka_filesystem":               resourceFilesystem(),
+				"weka_filesystem_group":         resourceFilesystemGroup(),
+				"weka_user":                     resourceUser(),
+				"weka_ldap_role_mapping":        resourceLDAPRoleMapping(),
+				"weka_organization_quota":       resourceOrganizationQuota(),
+				"weka_s3_policy":                resourceS3Policy(),
+				"weka_user_s3_policy":           resourceUserPolicy(),
+				"weka_s3_bucket":                resourceS3Bucket(),
+				"weka_s3_cluster":               resourceS3Cluster(),
+				"weka_s3_access_key":            resourceS3AccessKey(),
+				"weka_s3_tls_certificate":       resourceS3TLSCertificate(),
+				"weka_s3_audit_log":             resourceS3AuditLog(),
+				"weka_s3_bucket_policy":         resourceS3BucketPolicy(),
+				"weka_obs":                      resourceOBS(),
+				"weka_obs_store":                resourceOBSStore(),
+				"weka_snapshot":                 resourceSnapshot(),
+				"weka_snapshot_schedule":        resourceSnapshotSchedule(),
+				"weka_snapshot_restore":         resourceSnapshotRestore(),
+				"weka_default_quota":            resourceDefaultQuota(),
+				"weka_nfs_client_group_dns":     resourceNFSClientGroupDNS(),
+				"weka_nfs_client_group_ip":      resourceNFSClientGroupIP(),
+				"weka_interface_group_port":     resourceInterfaceGroupPort(),
+				"weka_interface_group_ip_range": resourceInterfaceGroupIPRange(),
+				"weka_interface_group":          resourceInterfaceGroup(),
+				"weka_nfs_global_config":        resourceNFSGlobalConfig(),
+				"weka_smb_active_directory":     resourceSMBActiveDirectory(),
+				"weka_smb_share":                resourceSMBShare(),
+				"weka_smb_cluster":              resourceSMBCluster(),
+			},
+			DataSourcesMap: map[string]*schema.Resource{
+				"weka_snapshots":              dataSourceSnapshots(),
+				"weka_quotas":                 dataSourceQuotas(),
+				"weka_nfs_permissions":        dataSourceNFSPermissions(),
+				"weka_nfs_client_groups":      dataSourceNFSClientGroups(),
+				"weka_smb_cluster":            dataSourceSMBCluster(),
+				"weka_s3_cluster":             dataSourceS3Cluster(),
+				"weka_users":                  dataSourceUsers(),
+				"weka_user":                   dataSourceUser(),
+				"weka_user_token":             dataSourceUserToken(),
+				"weka_events":                 dataSourceEvents(),
+				"weka_stats":                  dataSourceStats(),
+				"weka_health":                 dataSourceHealth(),
+				"weka_cluster":                dataSourceCluster(),
+				"weka_hosts":                  dataSourceHosts(),
+				"weka_processes":              dataSourceProcesses(),
+				"weka_network_devices":        dataSourceNetworkDevices(),
+				"weka_license":                dataSourceLicense(),
+				"weka_capacity":               dataSourceCapacity(),
+				"weka_filesystem":             dataSourceFilesystem(),
+				"weka_filesystems":            dataSourceFilesystems(),
+				"weka_filesystem_group_usage": dataSourceFilesystemGroupUsage(),
+				"weka_obs_buckets":            dataSourceOBSBuckets(),
+				"weka_obs_stores":             dataSourceOBSStores(),
+				"weka_s3_policies":            dataSourceS3Policies(),
+				"weka_s3_policy_document":     dataSourceS3PolicyDocument(),
 			},
-			DataSourcesMap:       map[string]*schema.Resource{},
 			ConfigureContextFunc: providerConfigure,
 		}
 
@@ -103,6 +167,67 @@ type WekaClient struct {
 	org          string
 }
 
+// WekaAPIError is returned by makeRequest when Weka reports an error,
+// it carries the HTTP status code so callers can tell an object that
+// no longer exists apart from a genuine failure.
+type WekaAPIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Reason     string
+}
+
+func (e *WekaAPIError) Error() string {
+	if e.StatusCode == http.StatusOK {
+		return fmt.Sprintf("Error from Weka API: %s", e.Message)
+	}
+
+	if e.Message == "" {
+		return fmt.Sprintf("Non-200 status from Weka API: %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("Non-200 status from Weka API: %d, message: %s", e.StatusCode, e.Message)
+}
+
+// weka doesn't always use a 404 for objects that don't exist, some
+// endpoints return a 400 (or even a 200) with one of these in the
+// message or reason.
+var notFoundMessages = []string{
+	"not found",
+	"does not exist",
+	"doesn't exist",
+	"no such",
+	"unknown uid",
+}
+
+// isNotFoundError returns true if err is the Weka API saying the object
+// doesn't exist.
+func isNotFoundError(err error) bool {
+	var wae *WekaAPIError
+
+	if !errors.As(err, &wae) {
+		return false
+	}
+
+	if wae.StatusCode == http.StatusNotFound {
+		return true
+	}
+
+	if wae.StatusCode != http.StatusOK && wae.StatusCode != http.StatusBadRequest {
+		return false
+	}
+
+	text := strings.ToLower(wae.Message + " " + wae.Reason)
+
+	for _, m := range notFoundMessages {
+		if strings.Contains(text, m) {
+			return true
+		}
+	}
+
+	return false
+}
+
 type WekaErrorResponse struct {
 	Message string `json:"message"`
 	Data    struct {
@@ -124,7 +249,7 @@ func (w *WekaClient) makeRestEndpointURL(p string) url.URL {
 func addHeadersToRequest(r *http.Request, w *WekaClient) {
 	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", w.authResponse.Data.AccessToken))
 
-	if r.Method == "POST" || r.Method == "PUT" {
+	if r.Method == "POST" || r.Method == "PUT" || (r.Method == "DELETE" && r.Body != nil) {
 		r.Header.Set("Content-Type", "application/json; charset=utf-8")
 	}
 }
@@ -132,13 +257,13 @@ func addHeadersToRequest(r *http.Request, w *WekaClient) {
 func (w *WekaClient) makeRequest(r *http.Request) ([]byte, error) {
 	addHeadersToRequest(r, w)
 
-	requestDump, err := httputil.DumpRequest(r, true)
+	requestDump, err := dumpRequest(r)
 
 	if err != nil {
 		return nil, err
 	}
 
-	log.Printf("[DEBUG] Weka Request: %s\n", string(requestDump))
+	log.Printf("[DEBUG] Weka Request: %s\n", requestDump)
 
 	res, err := w.client.Do(r)
 
@@ -154,7 +279,7 @@ func (w *WekaClient) makeRequest(r *http.Request) ([]byte, error) {
 		return nil, err
 	}
 
-	log.Printf("[DEBUG] Weka Response: %s\n", body)
+	log.Printf("[DEBUG] Weka Response: %s\n", redactBody(body))
 
 	// is it JSON? is it an error?
 	// this seems a little backwards here, but weka can send an json error with an http error code, so try a json parse first so we can provide a help error message, then check http status code
@@ -167,7 +292,7 @@ func (w *WekaClient) makeRequest(r *http.Request) ([]byte, error) {
 
 		// response indicates an error
 		if wer.Data.Error != "" || wer.Data.Reason != "" {
-			return nil, fmt.Errorf("Error from Weka API: %s", wer.Message)
+			return nil, &WekaAPIError{StatusCode: res.StatusCode, Code: wer.Data.Error, Message: wer.Message, Reason: wer.Data.Reason}
 		}
 	} else {
 		log.Printf("[DEBUG] body did not parse.")
@@ -175,11 +300,7 @@ func (w *WekaClient) makeRequest(r *http.Request) ([]byte, error) {
 
 	// check status code
 	if res.StatusCode != http.StatusOK {
-		if message == "" {
-			return nil, fmt.Errorf("Non-200 status from Weka API: %d", res.StatusCode)
-		} else {
-			return nil, fmt.Errorf("Non-200 status from Weka API: %d, message: %s", res.StatusCode, message)
-		}
+		return nil, &WekaAPIError{StatusCode: res.StatusCode, Message: message}
 	}
 
 	return body, err
@@ -277,3 +398,71 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	return nil, diags
 }
+
+// makeRequestWhenIdle is makeRequest, retrying for up to timeout while
+// weka reports the object is busy, e.g a filesystem that is still
+// being resized.
+func (w *WekaClient) makeRequestWhenIdle(ctx context.Context, r *http.Request, timeout time.Duration) ([]byte, error) {
+	var body []byte
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		req := r.Clone(ctx)
+
+		// the body is used up by each attempt.
+		if r.GetBody != nil {
+			b, err := r.GetBody()
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			req.Body = b
+		}
+
+		var err error
+		body, err = w.makeRequest(req)
+
+		if isBusyError(err) {
+			log.Printf("[DEBUG] Weka is busy, retrying: %s", err)
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	return body, err
+}
+
+// weka's list endpoints can take a moment to include objects that have
+// just been created.
+const postCreateReadTimeout = 1 * time.Minute
+
+// readAfterCreate calls read once an object has been created, retrying
+// for a short while if the object can't be found yet rather than
+// concluding it is missing and planning to create it again.
+func readAfterCreate(ctx context.Context, d *schema.ResourceData, m interface{}, read schema.ReadContextFunc) diag.Diagnostics {
+	id := d.Id()
+	var diags diag.Diagnostics
+
+	err := resource.RetryContext(ctx, postCreateReadTimeout, func() *resource.RetryError {
+		d.SetId(id)
+		diags = read(ctx, d, m)
+
+		if !diags.HasError() && d.Id() == "" {
+			return resource.RetryableError(fmt.Errorf("%s was created but can't be found yet", id))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		d.SetId(id)
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+)
+
+const redacted = "REDACTED"
+
+// values of JSON keys containing any of these are never logged.
+var sensitiveKeys = []string{
+	"password",
+	"secret",
+	"token",
+	"private_key",
+	"key_pem",
+	"role_id",
+	"key_uid",
+}
+
+var privateKeyRegexp = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[^-]*-----END [A-Z ]*PRIVATE KEY-----`)
+
+var authorizationRegexp = regexp.MustCompile(`(?mi)^(Authorization:).*$`)
+
+func isSensitiveKey(k string) bool {
+	k = strings.ToLower(k)
+
+	for _, s := range sensitiveKeys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if isSensitiveKey(k) && e != nil {
+				v[k] = redacted
+			} else {
+				v[k] = redactValue(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(e)
+		}
+	case string:
+		return privateKeyRegexp.ReplaceAllString(v, redacted)
+	}
+
+	return v
+}
+
+// redactBody removes secrets from a request or response body before it
+// is logged. JSON bodies have the values of sensitive keys replaced,
+// anything else just has private keys removed.
+func redactBody(body []byte) string {
+	var parsed interface{}
+
+	// keep numbers as they were sent rather than as float64s.
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&parsed); err != nil {
+		return privateKeyRegexp.ReplaceAllString(string(body), redacted)
+	}
+
+	out, err := json.Marshal(redactValue(parsed))
+
+	if err != nil {
+		return redacted
+	}
+
+	return string(out)
+}
+
+// dumpRequest is httputil.DumpRequest with the bearer token and any
+// secrets in the body redacted.
+func dumpRequest(r *http.Request) (string, error) {
+	header, err := httputil.DumpRequest(r, false)
+
+	if err != nil {
+		return "", err
+	}
+
+	dump := authorizationRegexp.ReplaceAllString(string(header), "$1 "+redacted)
+
+	if r.GetBody == nil {
+		return dump, nil
+	}
+
+	rc, err := r.GetBody()
+
+	if err != nil {
+		return "", err
+	}
+
+	defer rc.Close()
+
+	body, err := io.ReadAll(rc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return dump + redactBody(body), nil
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlertDefinition() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Enables or disables an alert type. Weka doesn't allow alert thresholds to be changed, so an alert is disabled by muting it, and mutes always expire: the mute is renewed whenever terraform sees it has expired. The resource ID is the alert type. Destroying the resource unmutes the alert.",
+		ReadContext:   resourceAlertDefinitionRead,
+		CreateContext: resourceAlertDefinitionCreate,
+		UpdateContext: resourceAlertDefinitionUpdate,
+		DeleteContext: resourceAlertDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"alert_type": {
+				Description: "Alert type, as listed by `weka alerts types`, e.g 'NodeDisconnected'.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"mute_duration": {
+				Description:  "How long the alert is muted for when disabled, e.g '12h' or '30d'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "365d",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+[smhdw]$`), "must be a number followed by one of s, m, h, d or w"),
+			},
+			"muted_until": {
+				Description: "When the current mute expires, empty if the alert isn't muted.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaAlertTypes struct {
+	Data []struct {
+		Type       string `json:"type"`
+		Muted      bool   `json:"muted"`
+		MuteExpiry string `json:"mute_expiry"`
+	} `json:"data"`
+}
+
+func setAlertMuted(c *WekaClient, alert_type string, muted bool, duration string) error {
+	action := "unmute"
+	params := map[string]interface{}{}
+
+	if muted {
+		action = "mute"
+		params["duration"] = duration
+	}
+
+	body, err := json.Marshal(params)
+
+	if err != nil {
+		return err
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("alerts/%s/%s", alert_type, action))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(body))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+func resourceAlertDefinitionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("alerts/types")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaAlertTypes
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, a := range parsed.Data {
+		if a.Type != d.Id() {
+			continue
+		}
+
+		// an expired mute shows up as enabled, which makes terraform
+		// mute it again.
+		d.Set("alert_type", a.Type)
+		d.Set("enabled", !a.Muted)
+		d.Set("muted_until", a.MuteExpiry)
+
+		return diags
+	}
+
+	// alert types only disappear on upgrade.
+	d.SetId("")
+	return diags
+}
+
+func resourceAlertDefinitionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	if !d.Get("enabled").(bool) {
+		if err := setAlertMuted(c, d.Id(), false, ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAlertDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceAlertDefinitionCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceAlertDefinitionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	alert_type := d.Get("alert_type").(string)
+	enabled := d.Get("enabled").(bool)
+
+	if err := setAlertMuted(c, alert_type, !enabled, d.Get("mute_duration").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(alert_type)
+
+	return resourceAlertDefinitionRead(ctx, d, m)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAuditWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Forwards cluster events, including audit events, to the cluster's event webhook, e.g a SIEM. Destroying the resource removes the webhook. The auth token is not returned by the Weka API, so remote changes to it will not be detected.",
+		ReadContext:   resourceAuditWebhookRead,
+		CreateContext: resourceAuditWebhookCreate,
+		UpdateContext: resourceAuditWebhookUpdate,
+		DeleteContext: resourceAuditWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"destination": {
+				Description:  "URL of the webhook events are sent to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"auth_token": {
+				Description: "Token sent to the webhook in the Authorization header.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"min_severity": {
+				Description:  "Only forward events of at least this severity, must be one of: INFO, MINOR, MAJOR or CRITICAL.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "INFO",
+				ValidateFunc: validation.StringInSlice([]string{"INFO", "MINOR", "MAJOR", "CRITICAL"}, false),
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaAuditWebhook struct {
+	Data struct {
+		Enabled  bool   `json:"enabled"`
+		Endpoint string `json:"endpoint"`
+		Severity string `json:"min_severity"`
+	} `json:"data"`
+}
+
+func resourceAuditWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("events/webhook")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaAuditWebhook
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// nothing configured, the webhook was removed outside of terraform.
+	if parsed.Data.Endpoint == "" {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("enabled", parsed.Data.Enabled)
+	d.Set("destination", parsed.Data.Endpoint)
+
+	if parsed.Data.Severity != "" {
+		d.Set("min_severity", parsed.Data.Severity)
+	}
+
+	return diags
+}
+
+func resourceAuditWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("events/webhook")
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAuditWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceAuditWebhookCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceAuditWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"enable":       d.Get("enabled").(bool),
+		"endpoint":     d.Get("destination").(string),
+		"min_severity": d.Get("min_severity").(string),
+	}
+
+	if v := d.Get("auth_token").(string); v != "" {
+		createData["auth_token"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("events/webhook")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("audit_webhook")
+
+	return diags
+}
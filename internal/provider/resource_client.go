@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceClient() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages a stateless client. Weka has no API to register a stateless client, they join the cluster the first time they mount a filesystem, so this resource builds the mount command for the client's provisioning pipeline to run, and tracks whether the client has joined. The resource ID is the client's hostname. Destroying the resource removes the client from the cluster if it has joined.",
+		ReadContext:   resourceClientRead,
+		CreateContext: resourceClientCreate,
+		UpdateContext: resourceClientUpdate,
+		DeleteContext: resourceClientDelete,
+		CustomizeDiff: resourceClientCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backends": {
+				Description: "Backend hosts the client contacts to join the cluster.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"filesystem": {
+				Description: "Name of the filesystem to mount.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"mount_point": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "/mnt/weka",
+			},
+			"net": {
+				Description: "Network devices the client uses for the data path, e.g `eth1`, or `udp` for UDP mode.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cores": {
+				Description: "Number of cores dedicated to the client.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"memory_mb": {
+				Description: "Memory for the client in MB, Weka sizes it itself if not set.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"cache_mode": {
+				Description:  "Page cache mode, must be one of: readcache, writecache, coherent or none.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"readcache", "writecache", "coherent", "none"}, false),
+			},
+			"readonly": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"qos_max_throughput_mbps": {
+				Description:  "Caps the client's throughput to the cluster, in MB/s. Changing it only takes effect once the client remounts.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"qos_preferred_throughput_mbps": {
+				Description:  "Throughput, in MB/s, the client is favoured for when the cluster is busy. Changing it only takes effect once the client remounts.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"qos_max_ops": {
+				Description:  "Caps the client's IO operations per second. Changing it only takes effect once the client remounts.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"mount_options": {
+				Description: "The -o options for mounting the filesystem.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mount_command": {
+				Description: "Command the client runs to join the cluster and mount the filesystem.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"joined": {
+				Description: "Whether the client has joined the cluster.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"container_uid": {
+				Description: "UID of the client's container once it has joined.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// clientMountOptions builds the wekafs mount options from the
+// configuration.
+func clientMountOptions(d *schema.ResourceData) string {
+	options := []string{}
+
+	for _, n := range d.Get("net").([]interface{}) {
+		options = append(options, fmt.Sprintf("net=%s", n.(string)))
+	}
+
+	if v := d.Get("cores").(int); v > 0 {
+		options = append(options, fmt.Sprintf("num_cores=%d", v))
+	}
+
+	if v := d.Get("memory_mb").(int); v > 0 {
+		options = append(options, fmt.Sprintf("memory_mb=%d", v))
+	}
+
+	if v := d.Get("cache_mode").(string); v != "" && v != "none" {
+		options = append(options, v)
+	}
+
+	if d.Get("readonly").(bool) {
+		options = append(options, "ro")
+	}
+
+	if v := d.Get("qos_max_throughput_mbps").(int); v > 0 {
+		options = append(options, fmt.Sprintf("qos_max_throughput=%d", v))
+	}
+
+	if v := d.Get("qos_preferred_throughput_mbps").(int); v > 0 {
+		options = append(options, fmt.Sprintf("qos_preferred_throughput=%d", v))
+	}
+
+	if v := d.Get("qos_max_ops").(int); v > 0 {
+		options = append(options, fmt.Sprintf("qos_max_ops=%d", v))
+	}
+
+	return strings.Join(options, ",")
+}
+
+func setClientMount(d *schema.ResourceData) {
+	backends := []string{}
+	for _, b := range d.Get("backends").([]interface{}) {
+		backends = append(backends, b.(string))
+	}
+
+	options := clientMountOptions(d)
+	command := "mount -t wekafs"
+
+	if options != "" {
+		command = fmt.Sprintf("%s -o %s", command, options)
+	}
+
+	d.Set("mount_options", options)
+	d.Set("mount_command", fmt.Sprintf("%s %s/%s %s", command, strings.Join(backends, ","), d.Get("filesystem").(string), d.Get("mount_point").(string)))
+}
+
+// the mount command changes with the configuration, so show it as
+// unknown in the plan rather than as its old value.
+func resourceClientCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChanges("backends", "filesystem", "mount_point", "net", "cores", "memory_mb", "cache_mode", "readonly", "qos_max_throughput_mbps", "qos_preferred_throughput_mbps", "qos_max_ops") {
+		if err := d.SetNewComputed("mount_options"); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("mount_command")
+	}
+
+	return nil
+}
+
+func resourceClientRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	containers, err := getContainers(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("hostname", d.Id())
+	d.Set("joined", false)
+	d.Set("container_uid", "")
+
+	for _, h := range containers.Data {
+		if h.Hostname == d.Id() && h.Mode == "client" {
+			d.Set("joined", true)
+			d.Set("container_uid", h.UID)
+			break
+		}
+	}
+
+	return diags
+}
+
+func resourceClientDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	if uid := d.Get("container_uid").(string); uid != "" {
+		url := c.makeRestEndpointURL(fmt.Sprintf("containers/%s", uid))
+		req, err := http.NewRequest("DELETE", url.String(), nil)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceClientUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	setClientMount(d)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return resourceClientRead(ctx, d, m)
+}
+
+func resourceClientCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("hostname").(string))
+	setClientMount(d)
+	return resourceClientRead(ctx, d, m)
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceClusterUpgrade() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Upgrades the cluster to `target_version`, which must already be downloaded onto the cluster. The upgrade is rolling and waits for every host to finish. Destroying the resource leaves the cluster on its current release.",
+		ReadContext:   resourceClusterUpgradeRead,
+		CreateContext: resourceClusterUpgradeCreate,
+		UpdateContext: resourceClusterUpgradeUpdate,
+		DeleteContext: resourceClusterUpgradeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Hour),
+			Update: schema.DefaultTimeout(6 * time.Hour),
+		},
+		Schema: map[string]*schema.Schema{
+			"target_version": {
+				Description: "Release to upgrade the cluster to, e.g '4.2.1'.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"current_version": {
+				Description: "Release the cluster is running.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaUpgradeStatus struct {
+	Data struct {
+		Status        string `json:"status"`
+		TargetVersion string `json:"target_version"`
+		Error         string `json:"error"`
+		Hosts         []struct {
+			HostID   string `json:"host_id"`
+			Hostname string `json:"hostname"`
+			Status   string `json:"status"`
+			Release  string `json:"release"`
+		} `json:"hosts"`
+	} `json:"data"`
+}
+
+// releaseMatches reports whether the cluster's release is the target,
+// weka may report a build suffix on the release, e.g 4.2.1.5423-abc.
+func releaseMatches(release string, target string) bool {
+	return release == target || strings.HasPrefix(release, target+".") || strings.HasPrefix(release, target+"-")
+}
+
+func getUpgradeStatus(c *WekaClient) (*WekaUpgradeStatus, error) {
+	url := c.makeRestEndpointURL("upgrade")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var status WekaUpgradeStatus
+
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+func waitForUpgrade(ctx context.Context, c *WekaClient, target string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		status, err := getUpgradeStatus(c)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if status.Data.Status == "FAILED" {
+			return resource.NonRetryableError(fmt.Errorf("upgrade to %s failed: %s", target, status.Data.Error))
+		}
+
+		upgraded := 0
+
+		for _, h := range status.Data.Hosts {
+			if releaseMatches(h.Release, target) {
+				upgraded++
+			}
+		}
+
+		log.Printf("[DEBUG] upgrade to %s is %s, %d of %d hosts upgraded", target, status.Data.Status, upgraded, len(status.Data.Hosts))
+
+		if status.Data.Status == "IN_PROGRESS" || upgraded < len(status.Data.Hosts) {
+			return resource.RetryableError(fmt.Errorf("upgrade to %s in progress, %d of %d hosts upgraded", target, upgraded, len(status.Data.Hosts)))
+		}
+
+		cluster, err := getCluster(c)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !releaseMatches(cluster.Data.Release, target) {
+			return resource.RetryableError(fmt.Errorf("cluster is on %s, waiting for %s", cluster.Data.Release, target))
+		}
+
+		return nil
+	})
+}
+
+func resourceClusterUpgradeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("current_version", cluster.Data.Release)
+
+	// the cluster was moved to another release outside of terraform,
+	// show it so the next apply upgrades it again.
+	if !releaseMatches(cluster.Data.Release, d.Get("target_version").(string)) {
+		d.Set("target_version", cluster.Data.Release)
+	}
+
+	return diags
+}
+
+// Do Nothing. A cluster can't be downgraded by removing the upgrade.
+func resourceClusterUpgradeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.SetId("")
+	return diags
+}
+
+func resourceClusterUpgradeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := upgradeCluster(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceClusterUpgradeRead(ctx, d, m)
+}
+
+func resourceClusterUpgradeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := upgradeCluster(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId("cluster_upgrade")
+
+	return resourceClusterUpgradeRead(ctx, d, m)
+}
+
+func upgradeCluster(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	target := d.Get("target_version").(string)
+
+	cluster, err := getCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if releaseMatches(cluster.Data.Release, target) {
+		return diags
+	}
+
+	upgradeBody, err := json.Marshal(map[string]interface{}{
+		"target_version": target,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("upgrade")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(upgradeBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForUpgrade(ctx, c, target, timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceContainer() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the cores and memory given to an existing Weka container. Applying a change restarts the container's processes. The resource ID is the container UID. Destroying the resource leaves the container as it is.",
+		ReadContext:   resourceContainerRead,
+		CreateContext: resourceContainerCreate,
+		UpdateContext: resourceContainerUpdate,
+		DeleteContext: resourceContainerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"container_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cores": {
+				Description:  "Total number of cores the container uses.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"compute_cores": {
+				Description: "Cores dedicated to compute processes.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"drives_cores": {
+				Description: "Cores dedicated to drive processes.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"frontend_cores": {
+				Description: "Cores dedicated to frontend processes.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"memory": {
+				Description:      "Memory the container uses, e.g '32GiB'. Weka sizes memory itself if not set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCapacity,
+				DiffSuppressFunc: capacityExactDiff,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	container, err := getContainer(c, d.Id())
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	d.Set("container_uid", container.Data.UID)
+	d.Set("hostname", container.Data.Hostname)
+	d.Set("cores", container.Data.Cores)
+	d.Set("compute_cores", container.Data.ComputeDedicatedCores)
+	d.Set("drives_cores", container.Data.DrivesDedicatedCores)
+	d.Set("frontend_cores", container.Data.FrontendDedicatedCores)
+
+	if d.Get("memory").(string) != "" {
+		d.Set("memory", formatCapacity(container.Data.Memory))
+	}
+
+	return diags
+}
+
+// Do Nothing. The container belongs to the host, not this resource.
+func resourceContainerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.SetId("")
+	return diags
+}
+
+func resourceContainerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := applyContainerResources(ctx, d, m, d.Timeout(schema.TimeoutUpdate))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceContainerRead(ctx, d, m)
+}
+
+func resourceContainerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("container_uid").(string))
+
+	diags := applyContainerResources(ctx, d, m, d.Timeout(schema.TimeoutCreate))
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceContainerRead(ctx, d, m)
+}
+
+// resources are staged with one call and applied with another, which
+// restarts the container, so wait for it to come back up.
+func applyContainerResources(ctx context.Context, d *schema.ResourceData, m interface{}, timeout time.Duration) diag.Diagnostics {
+	c := m.(*WekaClient)
+	id := d.Id()
+
+	updateData := map[string]interface{}{
+		"cores": d.Get("cores").(int),
+	}
+
+	for k, field := range map[string]string{
+		"compute_cores":  "compute_dedicated_cores",
+		"drives_cores":   "drives_dedicated_cores",
+		"frontend_cores": "frontend_dedicated_cores",
+	} {
+		if d.HasChange(k) {
+			updateData[field] = d.Get(k).(int)
+		}
+	}
+
+	if v := d.Get("memory").(string); v != "" {
+		// already checked by validateCapacity
+		b, _ := parseCapacity(v)
+		updateData["memory"] = b
+	}
+
+	updateBody, err := json.Marshal(updateData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("containers/%s/resources", id))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	before, err := getContainer(c, id)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url = c.makeRestEndpointURL(fmt.Sprintf("containers/%s/resources/apply", id))
+	req, err = http.NewRequest("POST", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the container is still up for a moment after the apply, wait
+	// for the restart to begin before waiting for it to finish.
+	restarted := false
+
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		container, err := getContainer(c, id)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !restarted {
+			if container.Data.Status == "UP" && container.Data.StartTime == before.Data.StartTime {
+				return resource.RetryableError(fmt.Errorf("container %s hasn't restarted yet", id))
+			}
+
+			restarted = true
+		}
+
+		if container.Data.Status != "UP" {
+			return resource.RetryableError(fmt.Errorf("container %s is %s, waiting for it to restart", id, container.Data.Status))
+		}
+
+		return nil
+	})
+
+	return diag.FromErr(err)
+}
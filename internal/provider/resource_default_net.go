@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDefaultNet() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the range of IPs Weka gives to hosts' data network devices when they join the cluster without their own IPs. Set it before adding hosts that rely on it. Destroying the resource resets the range.",
+		ReadContext:   resourceDefaultNetRead,
+		CreateContext: resourceDefaultNetCreate,
+		UpdateContext: resourceDefaultNetUpdate,
+		DeleteContext: resourceDefaultNetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ip_range": {
+				Description: "Range of addresses given to hosts, e.g `10.0.0.10-10.0.0.200`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9.]+(-[0-9.]+)?$`),
+					"must be an IPv4 address or a range of addresses separated by a hyphen",
+				),
+			},
+			"gateway": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"netmask_bits": {
+				Description:  "Subnet mask length of the data network, e.g 24.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaDefaultNet struct {
+	Data struct {
+		Range       string `json:"range"`
+		Gateway     string `json:"gateway"`
+		NetmaskBits int    `json:"netmask_bits"`
+	} `json:"data"`
+}
+
+func resourceDefaultNetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("defaultNet")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaDefaultNet
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// nothing configured, the configuration was reset outside of terraform.
+	if parsed.Data.Range == "" {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("ip_range", parsed.Data.Range)
+	d.Set("gateway", parsed.Data.Gateway)
+	d.Set("netmask_bits", parsed.Data.NetmaskBits)
+
+	return diags
+}
+
+func resourceDefaultNetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("defaultNet")
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDefaultNetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceDefaultNetCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceDefaultNetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"range":        d.Get("ip_range").(string),
+		"netmask_bits": d.Get("netmask_bits").(int),
+	}
+
+	if v := d.Get("gateway").(string); v != "" {
+		createData["gateway"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("defaultNet")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("default_net")
+
+	return diags
+}
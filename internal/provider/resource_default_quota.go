@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDefaultQuota() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the default quota applied to new directories created under a path in a filesystem. The resource ID is the filesystem UID and path joined with a colon, e.g `fs_uid:/path`.",
+		ReadContext:   resourceDefaultQuotaRead,
+		CreateContext: resourceDefaultQuotaCreate,
+		UpdateContext: resourceDefaultQuotaUpdate,
+		DeleteContext: resourceDefaultQuotaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDefaultQuotaImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"fs_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"path": {
+				Description: "Path within the filesystem, new directories created directly under it get the default quota.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "/",
+			},
+			"hard_limit": {
+				Description:      "Hard limit for each new directory, e.g '10GiB'.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCapacity,
+				DiffSuppressFunc: capacityExactDiff,
+				AtLeastOneOf:     []string{"hard_limit", "soft_limit"},
+			},
+			"soft_limit": {
+				Description:      "Soft limit for each new directory, e.g '8GiB'.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCapacity,
+				DiffSuppressFunc: capacityExactDiff,
+			},
+			"grace_seconds": {
+				Description: "How long the soft limit may be exceeded before it is enforced.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"owner": {
+				Description: "Free text owner recorded against the quota.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaDefaultQuotas struct {
+	Data []struct {
+		Path           string `json:"path"`
+		HardLimitBytes int64  `json:"hard_limit_bytes"`
+		SoftLimitBytes int64  `json:"soft_limit_bytes"`
+		GraceSeconds   int    `json:"grace_seconds"`
+		Owner          string `json:"owner"`
+	} `json:"data"`
+}
+
+func resourceDefaultQuotaImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected fs_uid:path", d.Id())
+	}
+
+	d.Set("fs_uid", parts[0])
+	d.Set("path", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// limits are optional, weka treats 0 as unlimited.
+func quotaLimitBytes(d *schema.ResourceData, k string) int64 {
+	if v := d.Get(k).(string); v != "" {
+		// already checked by validateCapacity
+		b, _ := parseCapacity(v)
+		return b
+	}
+
+	return 0
+}
+
+// GET /fileSystems/$uid/defaultQuota returns all the default quotas for
+// the filesystem, so find ours.
+func resourceDefaultQuotaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s/defaultQuota", d.Get("fs_uid").(string)))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaDefaultQuotas
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, q := range parsed.Data {
+		if q.Path != d.Get("path").(string) {
+			continue
+		}
+
+		if q.HardLimitBytes > 0 {
+			d.Set("hard_limit", formatCapacity(q.HardLimitBytes))
+		} else {
+			d.Set("hard_limit", "")
+		}
+
+		if q.SoftLimitBytes > 0 {
+			d.Set("soft_limit", formatCapacity(q.SoftLimitBytes))
+		} else {
+			d.Set("soft_limit", "")
+		}
+
+		d.Set("grace_seconds", q.GraceSeconds)
+		d.Set("owner", q.Owner)
+		return diags
+	}
+
+	// no default quota on this path any more.
+	d.SetId("")
+	return diags
+}
+
+func resourceDefaultQuotaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	deleteBody, err := json.Marshal(map[string]interface{}{
+		"path": d.Get("path").(string),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s/defaultQuota", d.Get("fs_uid").(string)))
+	req, err := http.NewRequest("DELETE", url.String(), bytes.NewBuffer(deleteBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDefaultQuotaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceDefaultQuotaCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+// setting a default quota replaces any existing one for the path, so
+// this is used for both create and update.
+func resourceDefaultQuotaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"path":             d.Get("path").(string),
+		"hard_limit_bytes": quotaLimitBytes(d, "hard_limit"),
+		"soft_limit_bytes": quotaLimitBytes(d, "soft_limit"),
+		"grace_seconds":    d.Get("grace_seconds").(int),
+		"owner":            d.Get("owner").(string),
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fs_uid := d.Get("fs_uid").(string)
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s/defaultQuota", fs_uid))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", fs_uid, d.Get("path").(string)))
+
+	return diags
+}
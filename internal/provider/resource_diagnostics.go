@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDiagnostics() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Collects a diagnostics bundle for Weka support, optionally uploading it to Weka Home. A bundle is collected on create and whenever an argument or trigger changes, destroying the resource does nothing.",
+		ReadContext:   resourceDiagnosticsRead,
+		CreateContext: resourceDiagnosticsCreate,
+		DeleteContext: resourceDiagnosticsDelete,
+		Schema: map[string]*schema.Schema{
+			"host_ids": {
+				Description: "Only collect from these hosts, otherwise all backends are collected from.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"include_clients": {
+				Description: "Also collect from client hosts.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"upload": {
+				Description: "Upload the bundle to Weka Home once collected.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will collect diagnostics again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"bundle_id": {
+				Description: "ID of the collected bundle, to give to Weka support.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type WekaDiags struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+// Do Nothing. The collection is a one off action, there is nothing to read.
+func resourceDiagnosticsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+// Do Nothing. Bundles are cleaned up by Weka.
+func resourceDiagnosticsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.SetId("")
+	return diags
+}
+
+func resourceDiagnosticsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"clients": d.Get("include_clients").(bool),
+		"upload":  d.Get("upload").(bool),
+	}
+
+	if hosts := d.Get("host_ids").(*schema.Set).List(); len(hosts) > 0 {
+		host_ids := []string{}
+		for _, h := range hosts {
+			host_ids = append(host_ids, h.(string))
+		}
+		createData["host_ids"] = host_ids
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("diags")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaDiags
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("bundle_id", parsed.Data.ID)
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
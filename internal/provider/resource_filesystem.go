@@ -6,20 +6,34 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 )
 
 func resourceFilesystem() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Manages filesystems within Weka. Caveats: creating and manging a tiered file system with mulitple OBS buckets is currently not supported. A filesystems cannot be switched between tiered and non-tiered. OBS names cannot be changed. Gigabytes are defined as 1000000000 bytes",
+		Description:   "Manages filesystems within Weka. Caveats: only the OBS bucket named in obs_name is managed by this resource and decides whether it is tiered, use weka_obs to attach additional buckets and don't attach the obs_name bucket with weka_obs as well. Tiered filesystems are imported with an ID of `uid:obs_name`. Switching a filesystem to non-tiered detaches the OBS bucket, which migrates its data back to SSD. OBS names cannot be changed. Gigabytes are defined as 1000000000 bytes",
 		ReadContext:   resourceFilesystemRead,
 		CreateContext: resourceFilesystemCreate,
 		UpdateContext: resourceFilesystemUpdate,
 		DeleteContext: resourceFilesystemDelete,
+		CustomizeDiff: customdiff.Sequence(
+			resourceFilesystemValidateTiered,
+			resourceFilesystemValidateCapacities,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceFilesystemImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -31,18 +45,36 @@ func resourceFilesystem() *schema.Resource {
 				Required: true,
 			},
 			"total_capacity_gb": {
-				Description: "total capacity in gigabytes, defined as 1000000000 bytes",
-				Type:        schema.TypeInt,
-				Required:    true,
+				Description:  "total capacity in gigabytes, defined as 1000000000 bytes",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"total_capacity_gb", "total_capacity"},
+			},
+			"total_capacity": {
+				Description:      "total capacity as a human readable string, e.g '10TiB' or '500GB'. Alternative to total_capacity_gb.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCapacity,
+				DiffSuppressFunc: capacityDiff,
 			},
 			"obs_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"ssd_capacity_gb": {
-				Description: "SSD capacity in gigabytes, defined as 1000000000 bytes",
-				Type:        schema.TypeInt,
-				Optional:    true,
+				Description:   "SSD capacity in gigabytes, defined as 1000000000 bytes",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"ssd_capacity"},
+			},
+			"ssd_capacity": {
+				Description:      "SSD capacity as a human readable string, e.g '1TiB'. Alternative to ssd_capacity_gb.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCapacity,
+				DiffSuppressFunc: capacityDiff,
 			},
 			"encrypted": {
 				Type:     schema.TypeBool,
@@ -60,6 +92,103 @@ func resourceFilesystem() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			"data_reduction": {
+				Description: "Enable data reduction on the filesystem. Requires a cluster licensed for data reduction.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"auto_max_files": {
+				Description: "Let Weka automatically grow the maximum number of files as the filesystem capacity changes.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"max_files": {
+				Description: "Maximum number of files the filesystem can hold.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"from_snapshot_locator": {
+				Description: "Create the filesystem by downloading a Snap-To-Object snapshot from the OBS bucket named in obs_name, rather than creating an empty filesystem. Requires tiered to be true. Changing this forces a new filesystem.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"allow_capacity_reduction": {
+				Description: "Shrinking a filesystem below its used capacity can wedge it, so reducing total capacity is refused unless this is set to true.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"deletion_protection": {
+				Description: "When true the provider will refuse to delete the filesystem. Must be set to false and applied before the filesystem can be destroyed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"purge_on_delete": {
+				Description: "When deleting a tiered filesystem, also purge its data from the object store.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"metadata_budget": {
+				Description: "SSD capacity in bytes reserved for filesystem metadata. Defaults to the value Weka calculates from the filesystem capacity.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+			"ssd_budget": {
+				Description: "SSD budget in bytes as reported by Weka.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_ssd_metadata": {
+				Description: "SSD capacity in bytes used by metadata.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"available_ssd_metadata": {
+				Description: "SSD capacity in bytes available for metadata.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"uid": {
+				Description: "Weka UID of the filesystem.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Filesystem status as reported by Weka.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_ready": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"free_total": {
+				Description: "Free capacity in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_total": {
+				Description: "Used capacity in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_ssd": {
+				Description: "Used SSD capacity in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"available_ssd": {
+				Description: "Available SSD capacity in bytes.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"last_updated": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -69,45 +198,336 @@ func resourceFilesystem() *schema.Resource {
 	}
 }
 
+type WekaFilesystemData struct {
+	ID                   string `json:"id"`
+	AutoMaxFiles         bool   `json:"auto_max_files"`
+	MaxFiles             int    `json:"max_files"`
+	DataReduction        bool   `json:"data_reduction"`
+	UsedSsdData          int    `json:"used_ssd_data"`
+	Name                 string `json:"name"`
+	UID                  string `json:"uid"`
+	IsRemoving           bool   `json:"is_removing"`
+	GroupID              string `json:"group_id"`
+	IsCreating           bool   `json:"is_creating"`
+	FreeTotal            int    `json:"free_total"`
+	IsEncrypted          bool   `json:"is_encrypted"`
+	MetadataBudget       int    `json:"metadata_budget"`
+	UsedTotalData        int    `json:"used_total_data"`
+	UsedTotal            int    `json:"used_total"`
+	SsdBudget            int    `json:"ssd_budget"`
+	IsReady              bool   `json:"is_ready"`
+	GroupName            string `json:"group_name"`
+	AvailableTotal       int    `json:"available_total"`
+	Status               string `json:"status"`
+	UsedSsdMetadata      int    `json:"used_ssd_metadata"`
+	AuthRequired         bool   `json:"auth_required"`
+	AvailableSsdMetadata int    `json:"available_ssd_metadata"`
+	TotalBudget          int    `json:"total_budget"`
+	UsedSsd              int    `json:"used_ssd"`
+	ObsBuckets           []struct {
+		UID   string `json:"uid"`
+		State string `json:"state"`
+		ObsID string `json:"obsId"`
+		Mode  string `json:"mode"`
+		Name  string `json:"name"`
+	} `json:"obs_buckets"`
+	AvailableSsd int `json:"available_ssd"`
+	FreeSsd      int `json:"free_ssd"`
+}
+
 type WekaFilesystem struct {
-	Data struct {
-		ID                   string `json:"id"`
-		AutoMaxFiles         bool   `json:"auto_max_files"`
-		UsedSsdData          int    `json:"used_ssd_data"`
-		Name                 string `json:"name"`
-		UID                  string `json:"uid"`
-		IsRemoving           bool   `json:"is_removing"`
-		GroupID              string `json:"group_id"`
-		IsCreating           bool   `json:"is_creating"`
-		FreeTotal            int    `json:"free_total"`
-		IsEncrypted          bool   `json:"is_encrypted"`
-		MetadataBudget       int    `json:"metadata_budget"`
-		UsedTotalData        int    `json:"used_total_data"`
-		UsedTotal            int    `json:"used_total"`
-		SsdBudget            int    `json:"ssd_budget"`
-		IsReady              bool   `json:"is_ready"`
-		GroupName            string `json:"group_name"`
-		AvailableTotal       int    `json:"available_total"`
-		Status               string `json:"status"`
-		UsedSsdMetadata      int    `json:"used_ssd_metadata"`
-		AuthRequired         bool   `json:"auth_required"`
-		AvailableSsdMetadata int    `json:"available_ssd_metadata"`
-		TotalBudget          int    `json:"total_budget"`
-		UsedSsd              int    `json:"used_ssd"`
-		ObsBuckets           []struct {
-			UID   string `json:"uid"`
-			State string `json:"state"`
-			ObsID string `json:"obsId"`
-			Mode  string `json:"mode"`
-			Name  string `json:"name"`
-		} `json:"obs_buckets"`
-		AvailableSsd int `json:"available_ssd"`
-		FreeSsd      int `json:"free_ssd"`
-	} `json:"data"`
+	Data WekaFilesystemData `json:"data"`
+}
+
+type WekaFilesystems struct {
+	Data []WekaFilesystemData `json:"data"`
+}
+
+func getFilesystems(c *WekaClient) (*WekaFilesystems, error) {
+	url := c.makeRestEndpointURL("fileSystems")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var filesystems WekaFilesystems
+
+	if err := json.Unmarshal(body, &filesystems); err != nil {
+		return nil, err
+	}
+
+	return &filesystems, nil
+}
+
+func getFilesystem(c *WekaClient, uid string) (*WekaFilesystem, error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s", uid))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var filesystem WekaFilesystem
+
+	if err := json.Unmarshal(body, &filesystem); err != nil {
+		return nil, err
+	}
+
+	return &filesystem, nil
+}
+
+// lookupFilesystemUID finds the UID of a filesystem from its name, some
+// weka APIs only return the name.
+func lookupFilesystemUID(c *WekaClient, name string) (string, error) {
+	filesystems, err := getFilesystems(c)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, fs := range filesystems.Data {
+		if fs.Name == name {
+			return fs.UID, nil
+		}
+	}
+
+	return "", fmt.Errorf("filesystem %s not found", name)
 }
 
 const OurGb = 1000000000
 
+// weka reports capacities that aren't always an exact multiple of what
+// was asked for, so round to the nearest gigabyte rather than
+// truncating, otherwise we'd be a GB short on every refresh.
+func bytesToGb(b int64) int64 {
+	return (b + OurGb/2) / OurGb
+}
+
+// satisfied by both schema.ResourceData and schema.ResourceDiff
+type resourceGetter interface {
+	Get(string) interface{}
+}
+
+// filesystemCapacity returns the capacity in bytes for either "total"
+// or "ssd", preferring the human readable attribute when it is set.
+func filesystemCapacity(d resourceGetter, kind string) int64 {
+	if v := d.Get(kind + "_capacity").(string); v != "" {
+		// already checked by validateCapacity
+		b, _ := parseCapacity(v)
+		return b
+	}
+
+	return int64(d.Get(kind+"_capacity_gb").(int)) * OurGb
+}
+
+// ssd capacity the filesystem takes from the cluster, all of it for a
+// non-tiered filesystem.
+func filesystemProvisionedSsd(d resourceGetter) int64 {
+	if d.Get("tiered").(bool) {
+		return filesystemCapacity(d, "ssd")
+	}
+
+	return filesystemCapacity(d, "total")
+}
+
+// filesystemErrorPaths are the attributes to point at when weka refuses
+// a filesystem.
+func filesystemErrorPaths(d resourceGetter) map[string]string {
+	capacity := "total_capacity_gb"
+
+	if d.Get("total_capacity").(string) != "" {
+		capacity = "total_capacity"
+	}
+
+	return map[string]string{
+		wekaErrNameExists: "name",
+		wekaErrCapacity:   capacity,
+	}
+}
+
+// oldResourceGetter gets the prior values from a ResourceData or
+// ResourceDiff
+type oldResourceGetter struct {
+	d interface {
+		GetChange(string) (interface{}, interface{})
+	}
+}
+
+func (o oldResourceGetter) Get(k string) interface{} {
+	v, _ := o.d.GetChange(k)
+	return v
+}
+
+// obs_name and ssd capacity only make sense for tiered filesystems, and
+// are required by them. check the config rather than the state, as the
+// ssd capacity is computed.
+func resourceFilesystemValidateTiered(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("tiered") {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+
+	if config.IsNull() {
+		return nil
+	}
+
+	configured := func(k string) bool {
+		return !config.GetAttr(k).IsNull()
+	}
+
+	if d.Get("tiered").(bool) {
+		if !configured("obs_name") {
+			return fmt.Errorf("obs_name is required when tiered is true")
+		}
+
+		if !configured("ssd_capacity_gb") && !configured("ssd_capacity") {
+			return fmt.Errorf("one of ssd_capacity_gb or ssd_capacity is required when tiered is true")
+		}
+
+		return nil
+	}
+
+	for _, k := range []string{"obs_name", "ssd_capacity_gb", "ssd_capacity", "from_snapshot_locator"} {
+		if configured(k) {
+			return fmt.Errorf("%s can only be set when tiered is true", k)
+		}
+	}
+
+	return nil
+}
+
+// configuredCapacity returns the capacity in bytes for either "total"
+// or "ssd" from whichever form is in the config. ok is false if neither
+// is configured or the value isn't known yet, the _gb forms are
+// computed so their planned values can't be trusted otherwise.
+func configuredCapacity(d *schema.ResourceDiff, kind string) (capacity int64, ok bool) {
+	config := d.GetRawConfig()
+
+	if config.IsNull() {
+		return 0, false
+	}
+
+	for _, k := range []string{kind + "_capacity", kind + "_capacity_gb"} {
+		v := config.GetAttr(k)
+
+		if v.IsNull() {
+			continue
+		}
+
+		if !v.IsKnown() {
+			return 0, false
+		}
+
+		if k == kind+"_capacity" {
+			// already checked by validateCapacity
+			b, _ := parseCapacity(d.Get(k).(string))
+			return b, true
+		}
+
+		return int64(d.Get(k).(int)) * OurGb, true
+	}
+
+	return 0, false
+}
+
+// check the capacities make sense and that the cluster has enough
+// unprovisioned capacity for the filesystem at plan time, rather than
+// failing half way through an apply.
+func resourceFilesystemValidateCapacities(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChanges("total_capacity_gb", "total_capacity", "ssd_capacity_gb", "ssd_capacity", "tiered") {
+		return nil
+	}
+
+	if !d.NewValueKnown("tiered") {
+		return nil
+	}
+
+	total, total_ok := configuredCapacity(d, "total")
+	required, required_ok := total, total_ok
+
+	// a tiered filesystem only takes its ssd capacity from the cluster.
+	if d.Get("tiered").(bool) {
+		ssd, ssd_ok := configuredCapacity(d, "ssd")
+
+		if total_ok && ssd_ok && ssd > total {
+			return fmt.Errorf("SSD capacity (%s) can't be more than total capacity (%s)", formatCapacity(ssd), formatCapacity(total))
+		}
+
+		required, required_ok = ssd, ssd_ok
+	}
+
+	if !required_ok {
+		return nil
+	}
+
+	return checkUnprovisionedCapacity(d, m, required)
+}
+
+// checkUnprovisionedCapacity checks the cluster has the SSD capacity
+// the filesystem needs.
+func checkUnprovisionedCapacity(d *schema.ResourceDiff, m interface{}, required int64) error {
+	c, ok := m.(*WekaClient)
+
+	if !ok || c == nil {
+		return nil
+	}
+
+	// an existing filesystem already has its current capacity provisioned.
+	if d.Id() != "" {
+		required -= filesystemProvisionedSsd(oldResourceGetter{d})
+	}
+
+	if required <= 0 {
+		return nil
+	}
+
+	cluster, err := getCluster(c)
+
+	if err != nil {
+		return err
+	}
+
+	available := cluster.Data.Capacity.UnprovisionedBytes
+
+	if required > available {
+		return fmt.Errorf("filesystem needs %s more SSD capacity but the cluster only has %s unprovisioned", formatCapacity(required), formatCapacity(available))
+	}
+
+	return nil
+}
+
+// a tiered filesystem is imported as uid:obs_name, since we can't tell
+// which of its buckets belongs to this resource rather than weka_obs.
+func resourceFilesystemImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+		return nil, fmt.Errorf("unexpected import ID %q, expected uid or uid:obs_name", d.Id())
+	}
+
+	d.SetId(parts[0])
+
+	if len(parts) == 2 {
+		d.Set("obs_name", parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func extractFilesystemJsonData(body []byte, d *schema.ResourceData) error {
 	var kms WekaFilesystem
 
@@ -116,28 +536,64 @@ func extractFilesystemJsonData(body []byte, d *schema.ResourceData) error {
 	}
 
 	d.SetId(kms.Data.UID)
+	d.Set("uid", kms.Data.UID)
+	d.Set("status", kms.Data.Status)
+	d.Set("is_ready", kms.Data.IsReady)
+
+	// capacities reported while a filesystem is being created or
+	// removed are partial, keep what we had rather than writing
+	// nonsense to the state.
+	if kms.Data.IsCreating || kms.Data.IsRemoving {
+		return nil
+	}
 
-	ssd_capacity := (kms.Data.UsedSsd + kms.Data.AvailableSsd) / OurGb
-	total_capacity := (kms.Data.AvailableTotal + kms.Data.UsedTotal) / OurGb
+	ssd_capacity := int64(kms.Data.UsedSsd + kms.Data.AvailableSsd)
+	total_capacity := int64(kms.Data.AvailableTotal + kms.Data.UsedTotal)
 
-	if len(kms.Data.ObsBuckets) > 0 {
-		d.Set("ssd_capacity_gb", ssd_capacity)
-		d.Set("tiered", true)
+	// only the bucket named in obs_name belongs to this resource, any
+	// others are attached with weka_obs and don't make the filesystem
+	// tiered as far as we are concerned.
+	obs_name := d.Get("obs_name").(string)
+	tiered := false
 
-		if len(kms.Data.ObsBuckets) > 1 {
-			return fmt.Errorf("Tiered filesystems with more than one OBS bucket currently not supported.")
-		} else {
-			d.Set("obs_name", kms.Data.ObsBuckets[0].Name)
+	for _, b := range kms.Data.ObsBuckets {
+		if obs_name != "" && b.Name == obs_name {
+			tiered = true
+		}
+	}
+
+	if tiered {
+		d.Set("ssd_capacity_gb", bytesToGb(ssd_capacity))
+
+		if d.Get("ssd_capacity").(string) != "" {
+			d.Set("ssd_capacity", formatCapacity(ssd_capacity))
 		}
-	} else {
-		d.Set("tiered", false)
 	}
 
-	d.Set("total_capacity_gb", total_capacity)
+	d.Set("tiered", tiered)
+
+	d.Set("total_capacity_gb", bytesToGb(total_capacity))
+
+	if d.Get("total_capacity").(string) != "" {
+		d.Set("total_capacity", formatCapacity(total_capacity))
+	}
+
 	d.Set("encrypted", kms.Data.IsEncrypted)
 	d.Set("auth_required", kms.Data.AuthRequired)
 	d.Set("encrypted", kms.Data.IsEncrypted)
 	d.Set("group_name", kms.Data.GroupName)
+	d.Set("auto_max_files", kms.Data.AutoMaxFiles)
+	d.Set("max_files", kms.Data.MaxFiles)
+	d.Set("data_reduction", kms.Data.DataReduction)
+
+	d.Set("free_total", kms.Data.FreeTotal)
+	d.Set("used_total", kms.Data.UsedTotal)
+	d.Set("used_ssd", kms.Data.UsedSsd)
+	d.Set("available_ssd", kms.Data.AvailableSsd)
+	d.Set("metadata_budget", kms.Data.MetadataBudget)
+	d.Set("ssd_budget", kms.Data.SsdBudget)
+	d.Set("used_ssd_metadata", kms.Data.UsedSsdMetadata)
+	d.Set("available_ssd_metadata", kms.Data.AvailableSsdMetadata)
 
 	return nil
 }
@@ -157,9 +613,47 @@ func resourceFilesystemRead(ctx context.Context, d *schema.ResourceData, m inter
 	body, err := c.makeRequest(req)
 
 	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
 		return diag.FromErr(err)
 	}
 
+	var fs WekaFilesystem
+
+	if err := json.Unmarshal(body, &fs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// a filesystem on its way out is as good as gone.
+	if fs.Data.IsRemoving {
+		log.Printf("[WARN] filesystem %s is being removed, removing from state", id)
+		d.SetId("")
+		return diags
+	}
+
+	// wait for a filesystem that is still being created and read it
+	// again once it's settled.
+	if fs.Data.IsCreating {
+		if err := waitForFilesystemReady(ctx, c, id, d.Timeout(schema.TimeoutRead)); err != nil {
+			return diag.FromErr(err)
+		}
+
+		req, err := http.NewRequest("GET", url.String(), nil)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		body, err = c.makeRequest(req)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := extractFilesystemJsonData(body, d); err != nil {
 		return diag.FromErr(err)
 	}
@@ -171,15 +665,58 @@ func resourceFilesystemDelete(ctx context.Context, d *schema.ResourceData, m int
 	var diags diag.Diagnostics
 	c := m.(*WekaClient)
 
+	if d.Get("deletion_protection").(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("filesystem %s has deletion_protection enabled", d.Get("name").(string)),
+			Detail:   "set deletion_protection to false and apply before destroying this filesystem.",
+		})
+		return diags
+	}
+
+	deleteBody, err := json.Marshal(map[string]interface{}{
+		"purge_from_obs": d.Get("purge_on_delete").(bool),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	id := d.Id()
 	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s", id))
-	req, err := http.NewRequest("DELETE", url.String(), nil)
+	req, err := http.NewRequest("DELETE", url.String(), bytes.NewBuffer(deleteBody))
 
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if _, err := c.makeRequest(req); err != nil {
+	if _, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutDelete)); err != nil && !isNotFoundError(err) {
+		return wekaDiags(err, nil)
+	}
+
+	// weka removes filesystems asynchronously, the fs will sit in
+	// is_removing for a while, so wait for it to actually go away.
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		req, err := http.NewRequest("GET", url.String(), nil)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		_, err = c.makeRequest(req)
+
+		if err == nil {
+			return resource.RetryableError(fmt.Errorf("filesystem %s is still being removed", id))
+		}
+
+		if isNotFoundError(err) {
+			return nil
+		}
+
+		return resource.NonRetryableError(err)
+	})
+
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -188,25 +725,109 @@ func resourceFilesystemDelete(ctx context.Context, d *schema.ResourceData, m int
 	return diags
 }
 
+func attachFilesystemOBS(c *WekaClient, fsId string, obsName string, mode string) error {
+	attachBody, err := json.Marshal(map[string]interface{}{
+		"obs_name": obsName,
+		"mode":     mode,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s/objectStoreBuckets", fsId))
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(attachBody))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+func detachFilesystemOBS(c *WekaClient, fsId string, obsName string) error {
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s/objectStoreBuckets/%s", fsId, obsName))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
 func resourceFilesystemUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	c := m.(*WekaClient)
 
+	// enable partial state so a refused or failed update doesn't save
+	// the planned values.
+	d.Partial(true)
+
 	updateData := make(map[string]interface{})
 
 	if d.HasChange("name") {
 		updateData["new_name"] = d.Get("name").(string)
 	}
 
-	if d.HasChange("tiered") {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "cannot switch type of filesystem from tiered/non-tiered",
-		})
-		return diags
+	if d.HasChanges("total_capacity_gb", "total_capacity") {
+		old_capacity := filesystemCapacity(oldResourceGetter{d}, "total")
+		new_capacity := filesystemCapacity(d, "total")
+
+		if new_capacity < old_capacity && !d.Get("allow_capacity_reduction").(bool) {
+			filesystem, err := getFilesystem(c, d.Id())
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("refusing to reduce filesystem capacity from %s to %s", formatCapacity(old_capacity), formatCapacity(new_capacity)),
+				Detail:   fmt.Sprintf("filesystem currently uses %d bytes. Set allow_capacity_reduction to true to shrink the filesystem.", filesystem.Data.UsedTotal),
+			})
+			return diags
+		}
+
+		updateData["total_capacity"] = new_capacity
 	}
 
-	if d.HasChange("obs_name") {
+	// converting between tiered and non-tiered is done by attaching or
+	// detaching the OBS bucket, weka migrates the data back to SSD on
+	// detach.
+	if d.HasChange("tiered") {
+		if d.Get("tiered").(bool) {
+			obs_name := d.Get("obs_name").(string)
+
+			if obs_name == "" {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "obs_name must be set to convert a filesystem to tiered",
+				})
+				return diags
+			}
+
+			if err := attachFilesystemOBS(c, d.Id(), obs_name, "WRITABLE"); err != nil {
+				return diag.FromErr(err)
+			}
+
+			updateData["ssd_capacity"] = filesystemCapacity(d, "ssd")
+		} else {
+			// only ever detach our own bucket, leave any attached
+			// with weka_obs alone.
+			old_obs_name, _ := d.GetChange("obs_name")
+
+			if old_obs_name.(string) != "" {
+				if err := detachFilesystemOBS(c, d.Id(), old_obs_name.(string)); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+	} else if d.HasChange("obs_name") {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Cannot currently change the OBS name",
@@ -214,17 +835,28 @@ func resourceFilesystemUpdate(ctx context.Context, d *schema.ResourceData, m int
 		return diags
 	}
 
-	if d.HasChange("total_capacity_gb") {
-		updateData["total_capacity"] = d.Get("total_capacity_gb").(int) * OurGb
+	if d.HasChange("auth_required") {
+		updateData["auth_required"] = d.Get("auth_required")
+	}
 
+	if d.HasChange("auto_max_files") {
+		updateData["auto_max_files"] = d.Get("auto_max_files").(bool)
 	}
 
-	if d.HasChange("auth_required") {
-		updateData["auth_required"] = d.Get("auth_required")
+	if d.HasChange("max_files") {
+		updateData["max_files"] = d.Get("max_files").(int)
 	}
 
-	if d.Get("tiered").(bool) && d.HasChange("ssd_capacity_gb") {
-		updateData["ssd_capacity"] = d.Get("total_capacity_gb").(int) * OurGb
+	if d.HasChange("data_reduction") {
+		updateData["data_reduction"] = d.Get("data_reduction").(bool)
+	}
+
+	if d.HasChange("metadata_budget") {
+		updateData["metadata_budget"] = d.Get("metadata_budget").(int)
+	}
+
+	if d.Get("tiered").(bool) && d.HasChanges("ssd_capacity_gb", "ssd_capacity") {
+		updateData["ssd_capacity"] = filesystemCapacity(d, "ssd")
 	}
 
 	updateBody, err := json.Marshal(updateData)
@@ -236,19 +868,51 @@ func resourceFilesystemUpdate(ctx context.Context, d *schema.ResourceData, m int
 	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s", d.Id()))
 	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
 
-	body, err := c.makeRequest(req)
+	body, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return wekaDiags(err, filesystemErrorPaths(d))
 	}
 
 	extractFilesystemJsonData(body, d)
 
+	d.Partial(false)
 	d.Set("last_updated", time.Now().Format(time.RFC850))
 
 	return diags
 }
 
+// poll a filesystem until weka reports it as ready.
+func waitForFilesystemReady(ctx context.Context, c *WekaClient, id string, timeout time.Duration) error {
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s", id))
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		req, err := http.NewRequest("GET", url.String(), nil)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		body, err := c.makeRequest(req)
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		var fs WekaFilesystem
+
+		if err := json.Unmarshal(body, &fs); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if fs.Data.IsCreating || !fs.Data.IsReady {
+			return resource.RetryableError(fmt.Errorf("filesystem %s is not ready yet, status: %s", id, fs.Data.Status))
+		}
+
+		return nil
+	})
+}
+
 func resourceFilesystemCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	c := m.(*WekaClient)
@@ -256,19 +920,53 @@ func resourceFilesystemCreate(ctx context.Context, d *schema.ResourceData, m int
 	createData := map[string]interface{}{
 		"name":           d.Get("name").(string),
 		"group_name":     d.Get("group_name").(string),
-		"total_capacity": d.Get("total_capacity_gb").(int) * OurGb,
+		"total_capacity": filesystemCapacity(d, "total"),
 		"encrypted":      d.Get("encrypted").(bool),
 		"auth_required":  d.Get("auth_required").(bool),
 		"allow_no_kms":   d.Get("allow_no_kms").(bool),
 	}
 
 	obs_name := d.Get("obs_name").(string)
-	ssd_capacity_gb := d.Get("ssd_capacity_gb").(int)
 	tiered := d.Get("tiered").(bool)
 
+	if d.HasChange("auto_max_files") {
+		createData["auto_max_files"] = d.Get("auto_max_files").(bool)
+	}
+
+	if d.HasChange("max_files") {
+		createData["max_files"] = d.Get("max_files").(int)
+	}
+
+	if d.HasChange("data_reduction") {
+		createData["data_reduction"] = d.Get("data_reduction").(bool)
+	}
+
+	if d.HasChange("metadata_budget") {
+		createData["metadata_budget"] = d.Get("metadata_budget").(int)
+	}
+
 	if tiered {
 		createData["obs_name"] = obs_name
-		createData["ssd_capacity"] = ssd_capacity_gb * OurGb
+		createData["ssd_capacity"] = filesystemCapacity(d, "ssd")
+	}
+
+	// filesystems created from a snapshot are created via the download
+	// API, which returns straight away and fetches the data in the
+	// background.
+	endpoint := "fileSystems"
+	locator := d.Get("from_snapshot_locator").(string)
+
+	if locator != "" {
+		if !tiered || obs_name == "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "from_snapshot_locator requires a tiered filesystem with obs_name set",
+			})
+			return diags
+		}
+
+		createData["snapshot_locator"] = locator
+		endpoint = "fileSystems/download"
 	}
 
 	createBody, err := json.Marshal(createData)
@@ -277,13 +975,13 @@ func resourceFilesystemCreate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	url := c.makeRestEndpointURL("fileSystems")
+	url := c.makeRestEndpointURL(endpoint)
 	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
 
 	body, err := c.makeRequest(req)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return wekaDiags(err, filesystemErrorPaths(d))
 	}
 
 	var kms WekaFilesystem
@@ -294,5 +992,12 @@ func resourceFilesystemCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	d.SetId(kms.Data.UID)
 
-	return diags
+	if locator != "" {
+		if err := waitForFilesystemReady(ctx, c, kms.Data.UID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// read back so the computed status and usage attributes are populated
+	return resourceFilesystemRead(ctx, d, m)
 }
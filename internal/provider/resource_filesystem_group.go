@@ -18,6 +18,7 @@ func resourceFilesystemGroup() *schema.Resource {
 		CreateContext: resourceFileystemGroupCreate,
 		UpdateContext: resourceFileystemGroupUpdate,
 		DeleteContext: resourceFileystemGroupDelete,
+		CustomizeDiff: resourceFilesystemGroupCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -27,12 +28,37 @@ func resourceFilesystemGroup() *schema.Resource {
 				Required: true,
 			},
 			"target_ssd_retention": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Description:      "How long data is kept on SSD before being released, once it has been copied to the object store. A duration such as '1d' or '4h', a bare number is taken as seconds.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: durationDiff,
+				StateFunc:        normalizeDuration,
 			},
 			"start_demote": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Description:      "How long after being written data is copied to the object store. A duration such as '30m' or '1h', a bare number is taken as seconds.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: durationDiff,
+				StateFunc:        normalizeDuration,
+			},
+			"filesystems": {
+				Description: "Filesystems currently in the group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 			"last_updated": {
 				Type:     schema.TypeString,
@@ -61,13 +87,63 @@ func extractFilesystemGroupJsonData(body []byte, d *schema.ResourceData) error {
 	}
 
 	d.SetId(kms.Data.UID)
-	d.Set("start_demote", kms.Data.StartDemote)
-	d.Set("target_ssd_retention", kms.Data.TargetSSDRetention)
+	d.Set("start_demote", formatDuration(int64(kms.Data.StartDemote)))
+	d.Set("target_ssd_retention", formatDuration(int64(kms.Data.TargetSSDRetention)))
 	d.Set("name", kms.Data.Name)
 
 	return nil
 }
 
+// weka refuses a group that demotes data after it should already have
+// been released from SSD, check it at plan time rather than failing the
+// apply.
+func resourceFilesystemGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("start_demote") || !d.NewValueKnown("target_ssd_retention") {
+		return nil
+	}
+
+	start_demote, err := parseDuration(d.Get("start_demote").(string))
+
+	if err != nil {
+		return nil
+	}
+
+	target_ssd_retention, err := parseDuration(d.Get("target_ssd_retention").(string))
+
+	if err != nil {
+		return nil
+	}
+
+	if start_demote >= target_ssd_retention {
+		return fmt.Errorf("start_demote (%s) must be shorter than target_ssd_retention (%s), data has to be demoted to the object store before it can be released from SSD", formatDuration(start_demote), formatDuration(target_ssd_retention))
+	}
+
+	return nil
+}
+
+// setFilesystemGroupMembers lists the filesystems in the group, weka
+// only records membership on the filesystems themselves.
+func setFilesystemGroupMembers(c *WekaClient, d *schema.ResourceData) error {
+	filesystems, err := getFilesystems(c)
+
+	if err != nil {
+		return err
+	}
+
+	members := make([]map[string]interface{}, 0)
+
+	for _, fs := range filesystems.Data {
+		if fs.GroupName == d.Get("name").(string) {
+			members = append(members, map[string]interface{}{
+				"name": fs.Name,
+				"uid":  fs.UID,
+			})
+		}
+	}
+
+	return d.Set("filesystems", members)
+}
+
 func resourceFileystemGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*WekaClient)
 	var diags diag.Diagnostics
@@ -83,6 +159,11 @@ func resourceFileystemGroupRead(ctx context.Context, d *schema.ResourceData, m i
 	body, err := c.makeRequest(req)
 
 	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
 		return diag.FromErr(err)
 	}
 
@@ -90,6 +171,10 @@ func resourceFileystemGroupRead(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
+	if err := setFilesystemGroupMembers(c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -105,8 +190,8 @@ func resourceFileystemGroupDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	if _, err := c.makeRequest(req); err != nil {
-		return diag.FromErr(err)
+	if _, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutDelete)); err != nil && !isNotFoundError(err) {
+		return wekaDiags(err, nil)
 	}
 
 	d.SetId("")
@@ -125,11 +210,11 @@ func resourceFileystemGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if d.HasChange("target_ssd_retention") {
-		updateData["target_ssd_retention"] = d.Get("target_ssd_retention").(int)
+		updateData["target_ssd_retention"], _ = parseDuration(d.Get("target_ssd_retention").(string))
 	}
 
 	if d.HasChange("start_demote") {
-		updateData["start_demote"] = d.Get("start_demote")
+		updateData["start_demote"], _ = parseDuration(d.Get("start_demote").(string))
 	}
 
 	updateBody, err := json.Marshal(updateData)
@@ -141,13 +226,23 @@ func resourceFileystemGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystemGroups/%s", d.Id()))
 	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
 
-	body, err := c.makeRequest(req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutUpdate))
 
 	if err != nil {
+		return wekaDiags(err, map[string]string{wekaErrNameExists: "name"})
+	}
+
+	if err := extractFilesystemGroupJsonData(body, d); err != nil {
 		return diag.FromErr(err)
 	}
 
-	extractFilesystemJsonData(body, d)
+	if err := setFilesystemGroupMembers(c, d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.Set("last_updated", time.Now().Format(time.RFC850))
 
@@ -158,10 +253,14 @@ func resourceFileystemGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	c := m.(*WekaClient)
 
+	// already checked by validateDuration
+	target_ssd_retention, _ := parseDuration(d.Get("target_ssd_retention").(string))
+	start_demote, _ := parseDuration(d.Get("start_demote").(string))
+
 	createData := map[string]interface{}{
 		"name":                 d.Get("name").(string),
-		"target_ssd_retention": d.Get("target_ssd_retention").(int),
-		"start_demote":         d.Get("start_demote").(int),
+		"target_ssd_retention": target_ssd_retention,
+		"start_demote":         start_demote,
 	}
 
 	createBody, err := json.Marshal(createData)
@@ -176,7 +275,7 @@ func resourceFileystemGroupCreate(ctx context.Context, d *schema.ResourceData, m
 	body, err := c.makeRequest(req)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return wekaDiags(err, map[string]string{wekaErrNameExists: "name"})
 	}
 
 	var kms WekaFileystemGroup
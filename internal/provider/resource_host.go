@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceHost() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Adds a backend host to the cluster and activates it. Deactivating a host drains its data onto the rest of the cluster, which can take a long time. Use active rather than weka_maintenance_mode to take a managed host out of service, the two would fight over the container's state. Destroying the resource deactivates the host and removes it from the cluster.",
+		ReadContext:   resourceHostRead,
+		CreateContext: resourceHostCreate,
+		UpdateContext: resourceHostUpdate,
+		DeleteContext: resourceHostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(120 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ips": {
+				Description: "Management IPs of the host, otherwise the hostname is resolved.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"active": {
+				Description: "Whether the host is active, setting this to false deactivates the host without removing it.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"container_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHostRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	container, err := getContainer(c, d.Id())
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	d.Set("hostname", container.Data.Hostname)
+	d.Set("ips", container.Data.Ips)
+	d.Set("container_name", container.Data.ContainerName)
+	d.Set("status", container.Data.Status)
+	d.Set("active", container.Data.State == "ACTIVE")
+
+	return diags
+}
+
+func resourceHostDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	id := d.Id()
+
+	if d.Get("active").(bool) {
+		if err := setContainerActive(c, id, false); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForContainerState(ctx, c, id, "INACTIVE", d.Timeout(schema.TimeoutDelete)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("containers/%s", id))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceHostUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	if d.HasChange("active") {
+		active := d.Get("active").(bool)
+		state := "INACTIVE"
+
+		if active {
+			state = "ACTIVE"
+		}
+
+		if err := setContainerActive(c, d.Id(), active); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForContainerState(ctx, c, d.Id(), state, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceHostRead(ctx, d, m)
+}
+
+func resourceHostCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"hostname": d.Get("hostname").(string),
+	}
+
+	if ips := d.Get("ips").([]interface{}); len(ips) > 0 {
+		createData["ips"] = ips
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("containers")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var container WekaContainer
+
+	if err := json.Unmarshal(body, &container); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(container.Data.UID)
+
+	if d.Get("active").(bool) {
+		if err := setContainerActive(c, d.Id(), true); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := waitForContainerState(ctx, c, d.Id(), "ACTIVE", d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceHostRead(ctx, d, m)
+}
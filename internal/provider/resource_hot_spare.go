@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceHotSpare() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages how many failure domains the cluster keeps as hot spare. Destroying the resource leaves the setting as it is.",
+		ReadContext:   resourceHotSpareRead,
+		CreateContext: resourceHotSpareCreate,
+		UpdateContext: resourceHotSpareUpdate,
+		DeleteContext: resourceHotSpareDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"failure_domains": {
+				Description:  "Number of failure domains reserved as hot spare.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"hot_spare_bytes": {
+				Description: "Capacity reserved for the hot spare.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHotSpareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("failure_domains", cluster.Data.HotSpare)
+	d.Set("hot_spare_bytes", cluster.Data.Capacity.HotSpareBytes)
+
+	return diags
+}
+
+// Do Nothing. The cluster always has a hot spare setting.
+func resourceHotSpareDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.SetId("")
+	return diags
+}
+
+func resourceHotSpareUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceHotSpareCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceHotSpareCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	updateBody, err := json.Marshal(map[string]interface{}{
+		"hot_spare": d.Get("failure_domains").(int),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("cluster/hotSpare")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("hot_spare")
+
+	return resourceHotSpareRead(ctx, d, m)
+}
@@ -0,0 +1,320 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInterfaceGroup() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages an interface group, the floating IPs and host ports NFS and SMB are served from. IP ranges and ports can be managed here, or with weka_interface_group_ip_range and weka_interface_group_port, but not both: leave ip_ranges and ports unset when using those resources.",
+		ReadContext:   resourceInterfaceGroupRead,
+		CreateContext: resourceInterfaceGroupCreate,
+		UpdateContext: resourceInterfaceGroupUpdate,
+		DeleteContext: resourceInterfaceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Description:  "Protocol served by the group, must be one of: NFS or SMB.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NFS",
+				ValidateFunc: validation.StringInSlice([]string{"NFS", "SMB"}, false),
+			},
+			"subnet_mask": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"gateway": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"allow_manage_gids": {
+				Description: "Allow NFS to resolve a user's groups itself rather than trusting the client.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"ip_ranges": {
+				Description: "Floating IPs of the group, each a single address or a range of addresses, e.g `10.0.0.10-10.0.0.20`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^[0-9.]+(-[0-9.]+)?$`),
+						"must be an IPv4 address or a range of addresses separated by a hyphen",
+					),
+				},
+			},
+			"ports": {
+				Description: "Host network ports the floating IPs are served from.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_uid": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Description: "Network device on the host, e.g `eth1`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// member is nil for DELETEs, which don't take a body.
+func modifyInterfaceGroupMember(c *WekaClient, method string, path string, member map[string]interface{}) error {
+	var body io.Reader
+
+	if member != nil {
+		b, err := json.Marshal(member)
+
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewBuffer(b)
+	}
+
+	url := c.makeRestEndpointURL(path)
+	req, err := http.NewRequest(method, url.String(), body)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+// updateInterfaceGroupMembers adds and removes ip ranges and ports so the
+// group matches the configuration.
+func updateInterfaceGroupMembers(c *WekaClient, d *schema.ResourceData) error {
+	uid := d.Id()
+
+	if d.HasChange("ip_ranges") {
+		o, n := d.GetChange("ip_ranges")
+
+		for _, ips := range o.(*schema.Set).Difference(n.(*schema.Set)).List() {
+			err := modifyInterfaceGroupMember(c, "DELETE", fmt.Sprintf("interfaceGroups/%s/ips/%s", uid, ips.(string)), nil)
+
+			if err != nil && !isNotFoundError(err) {
+				return err
+			}
+		}
+
+		for _, ips := range n.(*schema.Set).Difference(o.(*schema.Set)).List() {
+			err := modifyInterfaceGroupMember(c, "POST", fmt.Sprintf("interfaceGroups/%s/ips", uid), map[string]interface{}{
+				"ips": ips.(string),
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("ports") {
+		o, n := d.GetChange("ports")
+
+		for _, p := range o.(*schema.Set).Difference(n.(*schema.Set)).List() {
+			port := p.(map[string]interface{})
+			err := modifyInterfaceGroupMember(c, "DELETE", fmt.Sprintf("interfaceGroups/%s/ports/%s/%s", uid, port["host_uid"].(string), port["port"].(string)), nil)
+
+			if err != nil && !isNotFoundError(err) {
+				return err
+			}
+		}
+
+		for _, p := range n.(*schema.Set).Difference(o.(*schema.Set)).List() {
+			port := p.(map[string]interface{})
+			err := modifyInterfaceGroupMember(c, "POST", fmt.Sprintf("interfaceGroups/%s/ports", uid), map[string]interface{}{
+				"host_uid": port["host_uid"].(string),
+				"port":     port["port"].(string),
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceInterfaceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	group, err := getInterfaceGroup(c, d.Id())
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	ports := make([]map[string]interface{}, 0)
+
+	for _, p := range group.Data.Ports {
+		ports = append(ports, map[string]interface{}{
+			"host_uid": p.HostUID,
+			"port":     p.Port,
+		})
+	}
+
+	d.Set("name", group.Data.Name)
+	d.Set("type", group.Data.Type)
+	d.Set("subnet_mask", group.Data.SubnetMask)
+	d.Set("gateway", group.Data.Gateway)
+	d.Set("allow_manage_gids", group.Data.AllowManage)
+	d.Set("ip_ranges", group.Data.Ips)
+	d.Set("ports", ports)
+	d.Set("status", group.Data.Status)
+
+	return diags
+}
+
+func resourceInterfaceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("interfaceGroups/%s", d.Id()))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceInterfaceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	if d.HasChanges("subnet_mask", "gateway", "allow_manage_gids") {
+		updateBody, err := json.Marshal(map[string]interface{}{
+			"subnet":            d.Get("subnet_mask").(string),
+			"gateway":           d.Get("gateway").(string),
+			"allow_manage_gids": d.Get("allow_manage_gids").(bool),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		url := c.makeRestEndpointURL(fmt.Sprintf("interfaceGroups/%s", d.Id()))
+		req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := c.makeRequest(req); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateInterfaceGroupMembers(c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceInterfaceGroupRead(ctx, d, m)
+}
+
+func resourceInterfaceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"name":              d.Get("name").(string),
+		"type":              d.Get("type").(string),
+		"subnet":            d.Get("subnet_mask").(string),
+		"allow_manage_gids": d.Get("allow_manage_gids").(bool),
+	}
+
+	if v := d.Get("gateway").(string); v != "" {
+		createData["gateway"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("interfaceGroups")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var group WekaInterfaceGroup
+
+	if err := json.Unmarshal(body, &group); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(group.Data.UID)
+
+	if err := updateInterfaceGroupMembers(c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceInterfaceGroupRead(ctx, d, m)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceInterfaceGroupIPRange() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Assigns a range of floating IPs to an NFS interface group. The resource ID is the interface group UID and range joined with a colon, e.g `group_uid:10.0.0.10-10.0.0.20`.",
+		ReadContext:   resourceInterfaceGroupIPRangeRead,
+		CreateContext: resourceInterfaceGroupIPRangeCreate,
+		DeleteContext: resourceInterfaceGroupIPRangeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInterfaceGroupIPRangeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"interface_group_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ips": {
+				Description: "A single address or a range of addresses, e.g `10.0.0.10-10.0.0.20`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[0-9.]+(-[0-9.]+)?$`),
+					"must be an IPv4 address or a range of addresses separated by a hyphen",
+				),
+			},
+		},
+	}
+}
+
+func resourceInterfaceGroupIPRangeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected group_uid:ips", d.Id())
+	}
+
+	d.Set("interface_group_uid", parts[0])
+	d.Set("ips", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceInterfaceGroupIPRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	group, err := getInterfaceGroup(c, d.Get("interface_group_uid").(string))
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	for _, ips := range group.Data.Ips {
+		if ips == d.Get("ips").(string) {
+			return diags
+		}
+	}
+
+	// the range was removed from the group outside of terraform.
+	d.SetId("")
+	return diags
+}
+
+func resourceInterfaceGroupIPRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("interfaceGroups/%s/ips/%s", d.Get("interface_group_uid").(string), d.Get("ips").(string)))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceInterfaceGroupIPRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	group_uid := d.Get("interface_group_uid").(string)
+	ips := d.Get("ips").(string)
+
+	createBody, err := json.Marshal(map[string]interface{}{
+		"ips": ips,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("interfaceGroups/%s/ips", group_uid))
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", group_uid, ips))
+
+	return diags
+}
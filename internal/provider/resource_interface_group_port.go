@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceInterfaceGroupPort() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Assigns a host network port to an NFS interface group. The resource ID is the interface group UID, host UID and port joined with colons, e.g `group_uid:host_uid:eth1`.",
+		ReadContext:   resourceInterfaceGroupPortRead,
+		CreateContext: resourceInterfaceGroupPortCreate,
+		DeleteContext: resourceInterfaceGroupPortDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInterfaceGroupPortImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"interface_group_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port": {
+				Description: "Network device on the host, e.g `eth1`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInterfaceGroupPortImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected group_uid:host_uid:port", d.Id())
+	}
+
+	d.Set("interface_group_uid", parts[0])
+	d.Set("host_uid", parts[1])
+	d.Set("port", parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceInterfaceGroupPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	group, err := getInterfaceGroup(c, d.Get("interface_group_uid").(string))
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	for _, p := range group.Data.Ports {
+		if p.HostUID == d.Get("host_uid").(string) && p.Port == d.Get("port").(string) {
+			d.Set("status", p.Status)
+			return diags
+		}
+	}
+
+	// the port was removed from the group outside of terraform.
+	d.SetId("")
+	return diags
+}
+
+func resourceInterfaceGroupPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("interfaceGroups/%s/ports/%s/%s",
+		d.Get("interface_group_uid").(string), d.Get("host_uid").(string), d.Get("port").(string)))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceInterfaceGroupPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	group_uid := d.Get("interface_group_uid").(string)
+	host_uid := d.Get("host_uid").(string)
+	port := d.Get("port").(string)
+
+	createBody, err := json.Marshal(map[string]interface{}{
+		"host_uid": host_uid,
+		"port":     port,
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("interfaceGroups/%s/ports", group_uid))
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", group_uid, host_uid, port))
+
+	return resourceInterfaceGroupPortRead(ctx, d, m)
+}
@@ -8,17 +8,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"net/http"
-	"strconv"
+	"strings"
 	"time"
 )
 
 func resourceKMS() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Manage KMS resource within Weka. Note: Weka API does not provide a read API for KMS configuration, as such a KMS configuration cannot be imported nor will remote changes be detected.",
+		Description:   "Manage KMS resource within Weka. Its ID is always `kms`. The Weka API does not return tokens, keys or certificates, so remote changes to those will not be detected and they are not populated on import.",
 		ReadContext:   resourceKMSRead,
 		CreateContext: resourceKMSCreate,
 		UpdateContext: resourceKMSUpdate,
 		DeleteContext: resourceKMSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"base_url": {
 				Type:     schema.TypeString,
@@ -34,9 +37,36 @@ func resourceKMS() *schema.Resource {
 				DefaultFunc: schema.EnvDefaultFunc("WEKA_VAULT_TOKEN", nil),
 				Sensitive:   true,
 			},
+			"vault_namespace": {
+				Description: "Vault Enterprise namespace the master key is in.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"approle_role_id": {
+				Description:  "AppRole role ID, used with approle_secret_id instead of token to authenticate to Vault.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"approle_secret_id"},
+			},
+			"approle_secret_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"approle_role_id"},
+			},
 			"server_endpoint": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"server_endpoints"},
+			},
+			"server_endpoints": {
+				Description: "KMIP servers of a highly available KMIP cluster, tried in order. Use instead of server_endpoint.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"key_uid": {
 				Type:        schema.TypeString,
@@ -57,10 +87,12 @@ func resourceKMS() *schema.Resource {
 				Sensitive:   true,
 			},
 			"ca_cert_pem": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("WEKA_VAULT_CA_CERT", nil),
-				Sensitive:   true,
+				Description:  "PEM encoded CA certificate of the KMIP servers, may contain the full CA chain.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("WEKA_VAULT_CA_CERT", nil),
+				Sensitive:    true,
+				ValidateFunc: validatePEMCertificates,
 			},
 			"use_vault": {
 				Type:     schema.TypeBool,
@@ -78,16 +110,62 @@ func resourceKMS() *schema.Resource {
 type WekaKMS struct {
 	Data struct {
 		Params struct {
-			MasterKeyName string `json:"master_key_name"`
-			BaseURL       string `json:"base_url"`
+			MasterKeyName   string   `json:"master_key_name"`
+			BaseURL         string   `json:"base_url"`
+			Namespace       string   `json:"namespace"`
+			ServerEndpoint  string   `json:"server_endpoint"`
+			ServerEndpoints []string `json:"server_endpoints"`
 		} `json:"params"`
 		KmsType string `json:"kms_type"`
 	} `json:"data"`
 }
 
-// Do Nothing. Not enough information is returned in the read to make any determination.
+// GET /kms only returns the non-secret parts of the configuration.
 func resourceKMSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("kms")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaKMS
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// KMS configuration was removed outside of terraform.
+	if parsed.Data.KmsType == "" {
+		d.SetId("")
+		return diags
+	}
+
+	use_vault := strings.Contains(strings.ToLower(parsed.Data.KmsType), "vault")
+	d.Set("use_vault", use_vault)
+
+	if use_vault {
+		d.Set("base_url", parsed.Data.Params.BaseURL)
+		d.Set("master_key_name", parsed.Data.Params.MasterKeyName)
+		d.Set("vault_namespace", parsed.Data.Params.Namespace)
+	} else if len(parsed.Data.Params.ServerEndpoints) > 0 {
+		d.Set("server_endpoints", parsed.Data.Params.ServerEndpoints)
+	} else {
+		d.Set("server_endpoint", parsed.Data.Params.ServerEndpoint)
+	}
+
+	// older versions of this provider used a timestamp.
+	d.SetId("kms")
+
 	return diags
 }
 
@@ -120,13 +198,13 @@ func resourceKMSCreate(ctx context.Context, d *schema.ResourceData, m interface{
 	var diags diag.Diagnostics
 	c := m.(*WekaClient)
 
-	createParams := make(map[string]string)
+	createParams := make(map[string]interface{})
 
 	vaultFields := []string{
-		"base_url", "master_key_name", "token",
+		"base_url", "master_key_name",
 	}
 	kmipFields := []string{
-		"server_endpoint", "key_uid", "client_cert_pem", "client_key_pem", "ca_cert_pem",
+		"key_uid", "client_cert_pem", "client_key_pem", "ca_cert_pem",
 	}
 
 	if d.Get("use_vault").(bool) {
@@ -141,6 +219,24 @@ func resourceKMSCreate(ctx context.Context, d *schema.ResourceData, m interface{
 
 			createParams[v] = d.Get(v).(string)
 		}
+
+		// vault needs either a token or an AppRole to authenticate
+		if role_id := d.Get("approle_role_id").(string); role_id != "" {
+			createParams["role_id"] = role_id
+			createParams["secret_id"] = d.Get("approle_secret_id").(string)
+		} else if token := d.Get("token").(string); token != "" {
+			createParams["token"] = token
+		} else {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Missing configuration value for token or approle_role_id to configure KMS for Vault",
+			})
+			return diags
+		}
+
+		if v := d.Get("vault_namespace").(string); v != "" {
+			createParams["namespace"] = v
+		}
 	} else {
 		for _, v := range kmipFields {
 			if d.Get(v).(string) == "" {
@@ -153,6 +249,23 @@ func resourceKMSCreate(ctx context.Context, d *schema.ResourceData, m interface{
 
 			createParams[v] = d.Get(v).(string)
 		}
+
+		endpoints := []string{}
+		for _, e := range d.Get("server_endpoints").([]interface{}) {
+			endpoints = append(endpoints, e.(string))
+		}
+
+		if len(endpoints) > 0 {
+			createParams["server_endpoints"] = endpoints
+		} else if v := d.Get("server_endpoint").(string); v != "" {
+			createParams["server_endpoint"] = v
+		} else {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Missing configuration value for server_endpoint or server_endpoints to configure KMIP",
+			})
+			return diags
+		}
 	}
 
 	createBody, err := json.Marshal(createParams)
@@ -168,7 +281,7 @@ func resourceKMSCreate(ctx context.Context, d *schema.ResourceData, m interface{
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId("kms")
 
 	return diags
 }
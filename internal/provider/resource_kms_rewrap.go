@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceKMSRewrap() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Rewraps filesystem encryption keys with the current KMS master key, e.g after rotating it in Vault or the KMIP server. Keys are rewrapped on create and whenever an argument or trigger changes, destroying the resource does nothing.",
+		ReadContext:   resourceKMSRewrapRead,
+		CreateContext: resourceKMSRewrapCreate,
+		DeleteContext: resourceKMSRewrapDelete,
+		Schema: map[string]*schema.Schema{
+			"new_key_uid": {
+				Description: "For KMIP, the UID of the new master key. Not needed for Vault, which rewraps with the latest version of the key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will run the rewrap again, e.g the master key version.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_rewrapped": {
+				Description: "Time the rewrap was run.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Do Nothing. The rewrap is a one off action, there is nothing to read.
+func resourceKMSRewrapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+// Do Nothing. Keys can't be unwrapped back to an old master key.
+func resourceKMSRewrapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.SetId("")
+	return diags
+}
+
+func resourceKMSRewrapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := make(map[string]interface{})
+
+	if v := d.Get("new_key_uid").(string); v != "" {
+		createData["new_key_uid"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("kms/rewrap")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("last_rewrapped", time.Now().Format(time.RFC3339))
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLDAPRoleMapping() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Maps LDAP groups to Weka roles, replacing any existing mappings. LDAP must already be configured on the cluster. Destroying the resource clears the mappings.",
+		ReadContext:   resourceLDAPRoleMappingRead,
+		CreateContext: resourceLDAPRoleMappingCreate,
+		UpdateContext: resourceLDAPRoleMappingUpdate,
+		DeleteContext: resourceLDAPRoleMappingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_admin_group": {
+				Description: "LDAP group whose members get the ClusterAdmin role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"org_admin_group": {
+				Description: "LDAP group whose members get the OrgAdmin role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"read_only_group": {
+				Description: "LDAP group whose members get the ReadOnly role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"regular_group": {
+				Description: "LDAP group whose members get the Regular role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"s3_group": {
+				Description: "LDAP group whose members get the S3 role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// attribute name -> weka field name
+var ldapRoleGroups = map[string]string{
+	"cluster_admin_group": "cluster_admin_group",
+	"org_admin_group":     "org_admin_group",
+	"read_only_group":     "readonly_group",
+	"regular_group":       "user_group",
+	"s3_group":            "s3_group",
+}
+
+type WekaLDAP struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+func putLDAPRoleMapping(c *WekaClient, groups map[string]interface{}) error {
+	updateBody, err := json.Marshal(groups)
+
+	if err != nil {
+		return err
+	}
+
+	url := c.makeRestEndpointURL("ldap")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+func resourceLDAPRoleMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("ldap")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaLDAP
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, field := range ldapRoleGroups {
+		group, _ := parsed.Data[field].(string)
+		d.Set(k, group)
+	}
+
+	return diags
+}
+
+func resourceLDAPRoleMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	groups := make(map[string]interface{})
+
+	for _, field := range ldapRoleGroups {
+		groups[field] = ""
+	}
+
+	if err := putLDAPRoleMapping(c, groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceLDAPRoleMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceLDAPRoleMappingCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceLDAPRoleMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	groups := make(map[string]interface{})
+
+	for k, field := range ldapRoleGroups {
+		groups[field] = d.Get(k).(string)
+	}
+
+	if err := putLDAPRoleMapping(c, groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("ldap_role_mapping")
+
+	return diags
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceMaintenanceMode() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Puts a container into maintenance while the resource exists. Creating the resource deactivates the container, which drains its data onto the rest of the cluster, and destroying it activates the container again. Both wait for the container to finish changing state. Don't use this on a container whose weka_host has active set to true, the host resource will try to reactivate it on the next apply; set active to false there instead. The resource ID is the container UID.",
+		ReadContext:   resourceMaintenanceModeRead,
+		CreateContext: resourceMaintenanceModeCreate,
+		DeleteContext: resourceMaintenanceModeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"container_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMaintenanceModeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	container, err := getContainer(c, d.Id())
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// the container was activated outside of terraform, so it's no
+	// longer in maintenance.
+	if container.Data.State == "ACTIVE" {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("hostname", container.Data.Hostname)
+
+	return diags
+}
+
+func resourceMaintenanceModeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	if err := setContainerActive(c, d.Id(), true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForContainerState(ctx, c, d.Id(), "ACTIVE", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceMaintenanceModeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	uid := d.Get("container_uid").(string)
+
+	if err := setContainerActive(c, uid, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(uid)
+
+	if err := waitForContainerState(ctx, c, uid, "INACTIVE", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMaintenanceModeRead(ctx, d, m)
+}
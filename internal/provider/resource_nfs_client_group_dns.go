@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNFSClientGroupDNS() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages a DNS rule on an NFS client group. The resource ID is the client group UID and DNS name joined with a colon, e.g `group_uid:host.example.com`.",
+		ReadContext:   resourceNFSClientGroupDNSRead,
+		CreateContext: resourceNFSClientGroupDNSCreate,
+		DeleteContext: resourceNFSClientGroupDNSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNFSClientGroupDNSImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"client_group_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dns": {
+				Description: "DNS name of the clients to match, may include wildcards e.g `*.example.com`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceNFSClientGroupDNSImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected group_uid:dns", d.Id())
+	}
+
+	d.Set("client_group_uid", parts[0])
+	d.Set("dns", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceNFSClientGroupDNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	group, err := getNFSClientGroup(c, d.Get("client_group_uid").(string))
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// the rule was removed outside of terraform.
+	if !group.hasRule("DNS", d.Get("dns").(string)) {
+		d.SetId("")
+	}
+
+	return diags
+}
+
+func resourceNFSClientGroupDNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	if err := modifyNFSClientGroupRule(c, "DELETE", d.Get("client_group_uid").(string), "dns", d.Get("dns").(string)); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceNFSClientGroupDNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	group_uid := d.Get("client_group_uid").(string)
+	dns := d.Get("dns").(string)
+
+	if err := modifyNFSClientGroupRule(c, "POST", group_uid, "dns", dns); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", group_uid, dns))
+
+	return diags
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNFSClientGroupIP() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages an IP rule on an NFS client group. The resource ID is the client group UID and rule joined with a colon, e.g `group_uid:10.0.0.0/255.255.255.0`.",
+		ReadContext:   resourceNFSClientGroupIPRead,
+		CreateContext: resourceNFSClientGroupIPCreate,
+		DeleteContext: resourceNFSClientGroupIPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNFSClientGroupIPImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"client_group_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ip": {
+				Description: "Network of the clients to match, either in CIDR notation e.g `10.0.0.0/24` or as an address and netmask e.g `10.0.0.0/255.255.255.0`. A plain address matches a single host.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					if _, err := normalizeNFSIPRule(val.(string)); err != nil {
+						errs = append(errs, fmt.Errorf("%q: %s", key, err))
+					}
+
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					o, err := normalizeNFSIPRule(old)
+
+					if err != nil {
+						return false
+					}
+
+					n, err := normalizeNFSIPRule(new)
+
+					if err != nil {
+						return false
+					}
+
+					return o == n
+				},
+			},
+		},
+	}
+}
+
+// normalizeNFSIPRule converts a CIDR, address/netmask or plain address
+// into the address/netmask form weka uses.
+func normalizeNFSIPRule(rule string) (string, error) {
+	addr, mask, found := strings.Cut(rule, "/")
+
+	ip := net.ParseIP(addr).To4()
+
+	if ip == nil {
+		return "", fmt.Errorf("%q is not a valid IPv4 address", addr)
+	}
+
+	if !found {
+		return fmt.Sprintf("%s/255.255.255.255", ip), nil
+	}
+
+	if m := net.ParseIP(mask).To4(); m != nil {
+		ones, bits := net.IPMask(m).Size()
+
+		// Size returns 0, 0 for non-canonical masks, e.g 255.0.255.0
+		if ones == 0 && bits == 0 {
+			return "", fmt.Errorf("%q is not a valid netmask", mask)
+		}
+
+		return fmt.Sprintf("%s/%s", ip.Mask(net.IPMask(m)), m), nil
+	}
+
+	_, network, err := net.ParseCIDR(rule)
+
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid CIDR or address/netmask", rule)
+	}
+
+	return fmt.Sprintf("%s/%s", network.IP, net.IP(network.Mask)), nil
+}
+
+func resourceNFSClientGroupIPImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected group_uid:ip", d.Id())
+	}
+
+	d.Set("client_group_uid", parts[0])
+	d.Set("ip", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceNFSClientGroupIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	rule, err := normalizeNFSIPRule(d.Get("ip").(string))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := getNFSClientGroup(c, d.Get("client_group_uid").(string))
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// the rule was removed outside of terraform.
+	if !group.hasRule("IP", rule) {
+		d.SetId("")
+	}
+
+	return diags
+}
+
+func resourceNFSClientGroupIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	rule, err := normalizeNFSIPRule(d.Get("ip").(string))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := modifyNFSClientGroupRule(c, "DELETE", d.Get("client_group_uid").(string), "ip", rule); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceNFSClientGroupIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	group_uid := d.Get("client_group_uid").(string)
+	rule, err := normalizeNFSIPRule(d.Get("ip").(string))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := modifyNFSClientGroupRule(c, "POST", group_uid, "ip", rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", group_uid, rule))
+
+	return diags
+}
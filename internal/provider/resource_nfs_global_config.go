@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceNFSGlobalConfig() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages cluster wide NFS settings, including NFSv4 and Kerberos. Destroying the resource resets Kerberos and leaves everything else as it is. The Kerberos admin password is not returned by the Weka API, so remote changes to Kerberos will not be detected.",
+		ReadContext:   resourceNFSGlobalConfigRead,
+		CreateContext: resourceNFSGlobalConfigCreate,
+		UpdateContext: resourceNFSGlobalConfigUpdate,
+		DeleteContext: resourceNFSGlobalConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"supported_versions": {
+				Description: "NFS versions served by the cluster, any of: V3 or V4.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"V3", "V4"}, false),
+				},
+			},
+			"config_fs": {
+				Description: "Name of the filesystem used to persist NFSv4 state, required to serve NFSv4.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"idmap_domain": {
+				Description: "NFSv4 ID mapping domain.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"enable_acl": {
+				Description: "Enable NFSv4 ACLs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"kerberos": {
+				Description: "Kerberos service configuration for NFS.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"realm_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"primary_server": {
+							Description: "Primary KDC server.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"secondary_server": {
+							Description: "Secondary KDC server.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"admin_server": {
+							Description: "KDC admin server.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"admin_username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"admin_password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaNFSGlobalConfig struct {
+	Data struct {
+		SupportedVersions []string `json:"supported_versions"`
+		ConfigFS          string   `json:"config_fs"`
+		IdmapDomain       string   `json:"idmap_domain"`
+		EnableACL         bool     `json:"enable_acl"`
+	} `json:"data"`
+}
+
+func resourceNFSGlobalConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("nfs/globalConfig")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaNFSGlobalConfig
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("supported_versions", parsed.Data.SupportedVersions)
+	d.Set("config_fs", parsed.Data.ConfigFS)
+	d.Set("idmap_domain", parsed.Data.IdmapDomain)
+	d.Set("enable_acl", parsed.Data.EnableACL)
+
+	return diags
+}
+
+func resetNFSKerberos(c *WekaClient) error {
+	url := c.makeRestEndpointURL("nfs/kerberos/reset")
+	req, err := http.NewRequest("POST", url.String(), nil)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+func resourceNFSGlobalConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	if len(d.Get("kerberos").([]interface{})) > 0 {
+		if err := resetNFSKerberos(c); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceNFSGlobalConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceNFSGlobalConfigCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceNFSGlobalConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	configData := make(map[string]interface{})
+
+	if d.HasChange("supported_versions") {
+		versions := []string{}
+		for _, v := range d.Get("supported_versions").(*schema.Set).List() {
+			versions = append(versions, v.(string))
+		}
+		configData["supported_versions"] = versions
+	}
+
+	if d.HasChange("config_fs") {
+		configData["config_fs"] = d.Get("config_fs").(string)
+	}
+
+	if d.HasChange("idmap_domain") {
+		configData["idmap_domain"] = d.Get("idmap_domain").(string)
+	}
+
+	if d.HasChange("enable_acl") {
+		configData["enable_acl"] = d.Get("enable_acl").(bool)
+	}
+
+	if len(configData) > 0 {
+		configBody, err := json.Marshal(configData)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		url := c.makeRestEndpointURL("nfs/globalConfig")
+		req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(configBody))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := c.makeRequest(req); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// kerberos is configured through its own API call
+	if d.HasChange("kerberos") {
+		kerberos := d.Get("kerberos").([]interface{})
+
+		if len(kerberos) == 0 {
+			if err := resetNFSKerberos(c); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			k := kerberos[0].(map[string]interface{})
+
+			kerberosBody, err := json.Marshal(map[string]interface{}{
+				"kdc_realm_name":       k["realm_name"],
+				"kdc_primary_server":   k["primary_server"],
+				"kdc_secondary_server": k["secondary_server"],
+				"kdc_admin_server":     k["admin_server"],
+				"kdc_admin_username":   k["admin_username"],
+				"kdc_admin_password":   k["admin_password"],
+			})
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			url := c.makeRestEndpointURL("nfs/kerberos/service")
+			req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(kerberosBody))
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if _, err := c.makeRequest(req); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	d.SetId("nfs")
+
+	return resourceNFSGlobalConfigRead(ctx, d, m)
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceOBS() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Attaches an additional object store bucket to a filesystem. The bucket named in a weka_filesystem's obs_name is owned by that resource and must not also be attached here. The Weka API does not allow an attachment to be modified, so any change will detach and re-attach the bucket. The resource ID is the filesystem UID and OBS name joined with a colon, e.g `fs_uid:obs_name`.",
+		ReadContext:   resourceOBSRead,
+		CreateContext: resourceOBSCreate,
+		DeleteContext: resourceOBSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOBSImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"fs_uid": {
+				Description: "UID of the filesystem to attach the bucket to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"obs_name": {
+				Description: "Name of the object store bucket.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"mode": {
+				Description:  "Must be one of: writable or remote. remote attaches the bucket read-only.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "writable",
+				ValidateFunc: validation.StringInSlice([]string{"writable", "remote"}, false),
+			},
+			"state": {
+				Description: "State of the attachment as reported by Weka.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceOBSImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected fs_uid:obs_name", d.Id())
+	}
+
+	d.Set("fs_uid", parts[0])
+	d.Set("obs_name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// the attachment is read from the filesystem's obs_buckets list
+func resourceOBSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s", d.Get("fs_uid").(string)))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	var fs WekaFilesystem
+
+	if err := json.Unmarshal(body, &fs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, b := range fs.Data.ObsBuckets {
+		if b.Name == d.Get("obs_name").(string) {
+			d.Set("mode", strings.ToLower(b.Mode))
+			d.Set("state", b.State)
+			return diags
+		}
+	}
+
+	// the bucket is no longer attached.
+	d.SetId("")
+	return diags
+}
+
+func resourceOBSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	if err := detachFilesystemOBS(c, d.Get("fs_uid").(string), d.Get("obs_name").(string)); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceOBSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	fs_uid := d.Get("fs_uid").(string)
+	obs_name := d.Get("obs_name").(string)
+
+	if err := attachFilesystemOBS(c, fs_uid, obs_name, strings.ToUpper(d.Get("mode").(string))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", fs_uid, obs_name))
+
+	return resourceOBSRead(ctx, d, m)
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceOBSStore() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages object store buckets within Weka, these can then be used for tiering by weka_filesystem or weka_obs. The secret key is not returned by the Weka API, so remote changes to it will not be detected.",
+		ReadContext:   resourceOBSStoreRead,
+		CreateContext: resourceOBSStoreCreate,
+		UpdateContext: resourceOBSStoreUpdate,
+		DeleteContext: resourceOBSStoreDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"site": {
+				Description:  "Must be one of: local or remote. Changing this forces a new object store bucket.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "local",
+				ValidateFunc: validation.StringInSlice([]string{"local", "remote"}, false),
+			},
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  443,
+			},
+			"bucket": {
+				Description: "Name of the bucket on the object store.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"protocol": {
+				Description:  "Must be one of: HTTP, HTTPS or HTTPS_UNVERIFIED.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HTTPS",
+				ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS", "HTTPS_UNVERIFIED"}, false),
+			},
+			"auth_method": {
+				Description:  "Must be one of: None, AWSSignature2 or AWSSignature4.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AWSSignature4",
+				ValidateFunc: validation.StringInSlice([]string{"None", "AWSSignature2", "AWSSignature4"}, false),
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"access_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"secret_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaOBSStoreData struct {
+	UID         string `json:"uid"`
+	Name        string `json:"name"`
+	Site        string `json:"site"`
+	Hostname    string `json:"hostname"`
+	Port        string `json:"port"`
+	Bucket      string `json:"bucket"`
+	Protocol    string `json:"protocol"`
+	AuthMethod  string `json:"auth_method"`
+	Region      string `json:"region"`
+	AccessKeyID string `json:"access_key_id"`
+	Status      string `json:"status"`
+}
+
+type WekaOBSStore struct {
+	Data WekaOBSStoreData `json:"data"`
+}
+
+type WekaOBSStores struct {
+	Data []WekaOBSStoreData `json:"data"`
+}
+
+func extractOBSStoreJsonData(body []byte, d *schema.ResourceData) error {
+	var obs WekaOBSStore
+
+	if err := json.Unmarshal(body, &obs); err != nil {
+		return err
+	}
+
+	d.SetId(obs.Data.UID)
+	d.Set("name", obs.Data.Name)
+	d.Set("site", obs.Data.Site)
+	d.Set("hostname", obs.Data.Hostname)
+	d.Set("bucket", obs.Data.Bucket)
+	d.Set("protocol", obs.Data.Protocol)
+	d.Set("auth_method", obs.Data.AuthMethod)
+	d.Set("region", obs.Data.Region)
+	d.Set("access_key_id", obs.Data.AccessKeyID)
+	d.Set("status", obs.Data.Status)
+
+	// weka returns the port as a string
+	var port int
+	if _, err := fmt.Sscanf(obs.Data.Port, "%d", &port); err == nil {
+		d.Set("port", port)
+	}
+
+	return nil
+}
+
+// fields sent on both create and update
+func obsStoreParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"hostname":      d.Get("hostname").(string),
+		"port":          d.Get("port").(int),
+		"bucket":        d.Get("bucket").(string),
+		"protocol":      d.Get("protocol").(string),
+		"auth_method":   d.Get("auth_method").(string),
+		"region":        d.Get("region").(string),
+		"access_key_id": d.Get("access_key_id").(string),
+		"secret_key":    d.Get("secret_key").(string),
+	}
+}
+
+func resourceOBSStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	url := c.makeRestEndpointURL(fmt.Sprintf("objectStoreBuckets/%s", id))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if err := extractOBSStoreJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceOBSStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	id := d.Id()
+	url := c.makeRestEndpointURL(fmt.Sprintf("objectStoreBuckets/%s", id))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutDelete)); err != nil && !isNotFoundError(err) {
+		return wekaDiags(err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceOBSStoreUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	updateData := obsStoreParams(d)
+
+	if d.HasChange("name") {
+		updateData["new_name"] = d.Get("name").(string)
+	}
+
+	updateBody, err := json.Marshal(updateData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("objectStoreBuckets/%s", d.Id()))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := extractOBSStoreJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return diags
+}
+
+func resourceOBSStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := obsStoreParams(d)
+	createData["name"] = d.Get("name").(string)
+	createData["site"] = d.Get("site").(string)
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("objectStoreBuckets")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return wekaDiags(err, map[string]string{wekaErrNameExists: "name"})
+	}
+
+	if err := extractOBSStoreJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceOrganizationQuota() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the SSD and total capacity quotas of an organization. The resource ID is the organization UID. Destroying the resource removes the quotas, leaving the organization unlimited.",
+		ReadContext:   resourceOrganizationQuotaRead,
+		CreateContext: resourceOrganizationQuotaCreate,
+		UpdateContext: resourceOrganizationQuotaUpdate,
+		DeleteContext: resourceOrganizationQuotaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"org_uid": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ssd_quota": {
+				Description:      "SSD capacity the organization may allocate to filesystems, e.g '10TiB'.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCapacity,
+				DiffSuppressFunc: capacityExactDiff,
+				AtLeastOneOf:     []string{"ssd_quota", "total_quota"},
+			},
+			"total_quota": {
+				Description:      "Total capacity the organization may allocate to filesystems, e.g '100TiB'.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCapacity,
+				DiffSuppressFunc: capacityExactDiff,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ssd_allocated_bytes": {
+				Description: "SSD capacity currently allocated by the organization's filesystems.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"total_allocated_bytes": {
+				Description: "Total capacity currently allocated by the organization's filesystems.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaOrganization struct {
+	Data struct {
+		UID            string `json:"uid"`
+		Name           string `json:"name"`
+		SsdQuota       int64  `json:"ssd_quota"`
+		TotalQuota     int64  `json:"total_quota"`
+		SsdAllocated   int64  `json:"ssd_allocated"`
+		TotalAllocated int64  `json:"total_allocated"`
+	} `json:"data"`
+}
+
+func setOrganizationLimits(c *WekaClient, uid string, ssd_quota int64, total_quota int64) error {
+	updateBody, err := json.Marshal(map[string]interface{}{
+		"ssd_quota":   ssd_quota,
+		"total_quota": total_quota,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("organizations/%s/limits", uid))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+func resourceOrganizationQuotaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("organizations/%s", d.Id()))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	var org WekaOrganization
+
+	if err := json.Unmarshal(body, &org); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("org_uid", d.Id())
+	d.Set("name", org.Data.Name)
+	d.Set("ssd_allocated_bytes", org.Data.SsdAllocated)
+	d.Set("total_allocated_bytes", org.Data.TotalAllocated)
+
+	// weka uses 0 for unlimited
+	if org.Data.SsdQuota > 0 {
+		d.Set("ssd_quota", formatCapacity(org.Data.SsdQuota))
+	} else {
+		d.Set("ssd_quota", "")
+	}
+
+	if org.Data.TotalQuota > 0 {
+		d.Set("total_quota", formatCapacity(org.Data.TotalQuota))
+	} else {
+		d.Set("total_quota", "")
+	}
+
+	return diags
+}
+
+func resourceOrganizationQuotaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	if err := setOrganizationLimits(c, d.Id(), 0, 0); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceOrganizationQuotaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceOrganizationQuotaCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceOrganizationQuotaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	uid := d.Get("org_uid").(string)
+
+	err := setOrganizationLimits(c, uid, quotaLimitBytes(d, "ssd_quota"), quotaLimitBytes(d, "total_quota"))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(uid)
+
+	return resourceOrganizationQuotaRead(ctx, d, m)
+}
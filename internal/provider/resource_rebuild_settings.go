@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRebuildSettings() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the share of cluster bandwidth given to the data scrubber and to rebuilds. Destroying the resource leaves the settings as they are.",
+		ReadContext:   resourceRebuildSettingsRead,
+		CreateContext: resourceRebuildSettingsCreate,
+		UpdateContext: resourceRebuildSettingsUpdate,
+		DeleteContext: resourceRebuildSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"scrubber_enabled": {
+				Description: "Whether the background scrubber checks data for silent corruption.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"scrubber_rate_mbps": {
+				Description:  "Rate the scrubber reads data at, in MB per second per drive.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"rebuild_priority": {
+				Description:  "Priority of rebuild traffic over client IO, must be one of: low, normal or high.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"low", "normal", "high"}, false),
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaRebuildSettings struct {
+	Data struct {
+		ScrubberEnabled  bool   `json:"scrubber_enabled"`
+		ScrubberRateMbps int    `json:"scrubber_rate_mbps"`
+		RebuildPriority  string `json:"rebuild_priority"`
+	} `json:"data"`
+}
+
+func resourceRebuildSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("cluster/rebuildSettings")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaRebuildSettings
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("scrubber_enabled", parsed.Data.ScrubberEnabled)
+	d.Set("scrubber_rate_mbps", parsed.Data.ScrubberRateMbps)
+	d.Set("rebuild_priority", parsed.Data.RebuildPriority)
+
+	return diags
+}
+
+// Do Nothing. The cluster always has rebuild settings.
+func resourceRebuildSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.SetId("")
+	return diags
+}
+
+func resourceRebuildSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceRebuildSettingsCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceRebuildSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	// only send what's configured, anything else keeps weka's value.
+	updateData := make(map[string]interface{})
+
+	if v, ok := d.GetOk("scrubber_rate_mbps"); ok {
+		updateData["scrubber_rate_mbps"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("rebuild_priority"); ok {
+		updateData["rebuild_priority"] = v.(string)
+	}
+
+	if !d.GetRawConfig().GetAttr("scrubber_enabled").IsNull() {
+		updateData["scrubber_enabled"] = d.Get("scrubber_enabled").(bool)
+	}
+
+	updateBody, err := json.Marshal(updateData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("cluster/rebuildSettings")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("rebuild_settings")
+
+	return resourceRebuildSettingsRead(ctx, d, m)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceS3AccessKey() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Generates an S3 access key pair for a Weka user. The secret key is only returned by Weka when the key is created, it is stored in the Terraform state and cannot be recovered if lost. To rotate a key, change rotate_triggers or taint the resource.",
+		ReadContext:   resourceS3AccessKeyRead,
+		CreateContext: resourceS3AccessKeyCreate,
+		DeleteContext: resourceS3AccessKeyDelete,
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rotate_triggers": {
+				Description: "Arbitrary map of values that, when changed, will generate a new key pair and revoke the old one.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"access_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+type WekaS3AccessKey struct {
+	Data struct {
+		AccessKey string `json:"access_key"`
+		SecretKey string `json:"secret_key"`
+		Username  string `json:"username"`
+	} `json:"data"`
+}
+
+type WekaS3AccessKeys struct {
+	Data []struct {
+		AccessKey string `json:"access_key"`
+		Username  string `json:"username"`
+	} `json:"data"`
+}
+
+// the secret is never returned again, so all we can do is check the key
+// still exists.
+func resourceS3AccessKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("s3/accessKeys")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaS3AccessKeys
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, k := range parsed.Data {
+		if k.AccessKey == d.Id() {
+			d.Set("username", k.Username)
+			return diags
+		}
+	}
+
+	// the key was revoked outside of terraform.
+	d.SetId("")
+	return diags
+}
+
+func resourceS3AccessKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("s3/accessKeys/%s", d.Id()))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceS3AccessKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	createBody, err := json.Marshal(map[string]interface{}{
+		"username": d.Get("username").(string),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("s3/accessKeys")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var key WekaS3AccessKey
+
+	if err := json.Unmarshal(body, &key); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(key.Data.AccessKey)
+	d.Set("access_key", key.Data.AccessKey)
+	d.Set("secret_key", key.Data.SecretKey)
+
+	return readAfterCreate(ctx, d, m, resourceS3AccessKeyRead)
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceS3AuditLog() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages S3 audit logging to a webhook, one record per object access. Destroying the resource disables audit logging. The auth token is not returned by the Weka API, so remote changes to it will not be detected.",
+		ReadContext:   resourceS3AuditLogRead,
+		CreateContext: resourceS3AuditLogCreate,
+		UpdateContext: resourceS3AuditLogUpdate,
+		DeleteContext: resourceS3AuditLogDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"destination": {
+				Description:  "URL of the webhook audit records are sent to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"auth_token": {
+				Description: "Token sent to the webhook in the Authorization header.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"verbosity": {
+				Description:  "Amount of detail in each record, must be one of: minimal or full.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "minimal",
+				ValidateFunc: validation.StringInSlice([]string{"minimal", "full"}, false),
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaS3AuditLog struct {
+	Data struct {
+		Enabled   bool   `json:"enabled"`
+		Endpoint  string `json:"endpoint"`
+		Verbosity string `json:"verbosity"`
+	} `json:"data"`
+}
+
+func resourceS3AuditLogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("s3/auditWebhook")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaS3AuditLog
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// nothing configured, the webhook was removed outside of terraform.
+	if parsed.Data.Endpoint == "" {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("enabled", parsed.Data.Enabled)
+	d.Set("destination", parsed.Data.Endpoint)
+
+	if parsed.Data.Verbosity != "" {
+		d.Set("verbosity", parsed.Data.Verbosity)
+	}
+
+	return diags
+}
+
+func resourceS3AuditLogDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("s3/auditWebhook")
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceS3AuditLogUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceS3AuditLogCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceS3AuditLogCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"enable":    d.Get("enabled").(bool),
+		"endpoint":  d.Get("destination").(string),
+		"verbosity": d.Get("verbosity").(string),
+	}
+
+	if v := d.Get("auth_token").(string); v != "" {
+		createData["auth_token"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("s3/auditWebhook")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("s3_audit_log")
+
+	return diags
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
 	"regexp"
 	"time"
@@ -19,6 +20,9 @@ func resourceS3Bucket() *schema.Resource {
 		CreateContext: resourceS3BucketCreate,
 		UpdateContext: resourceS3BucketUpdate,
 		DeleteContext: resourceS3BucketDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
 				Description: "bucket name. renaming a bucket will result in delete & recreate",
@@ -63,10 +67,20 @@ func resourceS3Bucket() *schema.Resource {
 				},
 				Default: "none",
 			},
+			"policy_json": {
+				Description:      "JSON string containing a custom bucket policy, used in place of anonymous_policy_name. Leave unset when the policy is managed by weka_s3_bucket_policy.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: AWSPolicyDiff,
+				ConflictsWith:    []string{"anonymous_policy_name"},
+			},
 			"hard_quota": {
-				Description: "Storage quota, for example '1MB', cannot be used when existing_path is set",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "Storage quota, for example '1MB' or '10GiB', cannot be used when existing_path is set",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateCapacity,
+				DiffSuppressFunc: capacityExactDiff,
 			},
 			"existing_path": {
 				Description: "The Weka API does not provide a mechanism to update the existing path, updating this value will delete the bucket and create a new one.",
@@ -80,6 +94,16 @@ func resourceS3Bucket() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"used_bytes": {
+				Description: "Bytes currently used by the bucket.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"hard_limit_bytes": {
+				Description: "Bucket quota in bytes, 0 if the bucket has no quota.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"last_updated": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -128,10 +152,63 @@ func resourceS3BucketRead(ctx context.Context, d *schema.ResourceData, m interfa
 	for i := 0; i < len(parsed.Data.Buckets); i++ {
 		b := parsed.Data.Buckets[i]
 
-		// if the bucket exists, no change.
-		if b.Name == id {
-			return diags
+		if b.Name != id {
+			continue
+		}
+
+		d.Set("bucket_name", b.Name)
+		d.Set("used_bytes", b.UsedBytes)
+		d.Set("hard_limit_bytes", b.HardLimitBytes)
+
+		// keep the configured quota string if it's the same size,
+		// otherwise record what weka has.
+		current, err := parseCapacity(d.Get("hard_quota").(string))
+
+		if b.HardLimitBytes == 0 {
+			d.Set("hard_quota", "")
+		} else if err != nil || current != int64(b.HardLimitBytes) {
+			d.Set("hard_quota", formatCapacity(int64(b.HardLimitBytes)))
+		}
+
+		if d.Get("existing_path").(string) != "" {
+			d.Set("existing_path", b.Path)
+		}
+
+		fs_uid, err := lookupFilesystemUID(c, b.FileSystem)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.Set("fs_uid", fs_uid)
+
+		policy, err := getS3BucketPolicy(c, id)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// anything other than one of the presets means a custom
+		// policy has been set.
+		switch policy {
+		case "none", "download", "upload", "public":
+			d.Set("anonymous_policy_name", policy)
+			d.Set("policy_json", "")
+		default:
+			// only track the custom policy if we set it, it may
+			// belong to weka_s3_bucket_policy instead.
+			if d.Get("policy_json").(string) != "" {
+				policyDocument, err := getS3BucketPolicyJSON(c, id)
+
+				if err != nil {
+					return diag.FromErr(err)
+				}
+
+				d.Set("policy_json", policyDocument)
+			}
 		}
+
+		return diags
 	}
 
 	// the bucket wasn't found in the list, so tell terraform that it
@@ -140,6 +217,109 @@ func resourceS3BucketRead(ctx context.Context, d *schema.ResourceData, m interfa
 	return diags
 }
 
+// send quotas to weka in a single form so it never has to interpret
+// units differently to us, "" means no quota.
+func normalizeS3BucketQuota(quota string) string {
+	if quota == "" {
+		return ""
+	}
+
+	// already checked by validateCapacity
+	b, _ := parseCapacity(quota)
+
+	return formatCapacity(b)
+}
+
+type WekaS3BucketPolicy struct {
+	Data struct {
+		Policy string `json:"policy"`
+	} `json:"data"`
+}
+
+func getS3BucketPolicy(c *WekaClient, bucket string) (string, error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("/s3/buckets/%s/policy", bucket))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return "", err
+	}
+
+	var parsed WekaS3BucketPolicy
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", err
+	}
+
+	return parsed.Data.Policy, nil
+}
+
+func getS3BucketPolicyJSON(c *WekaClient, bucket string) (string, error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("/s3/buckets/%s/policyJson", bucket))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return "", err
+	}
+
+	var parsed struct {
+		Data struct {
+			Policy interface{} `json:"policy"`
+		} `json:"data"`
+	}
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", err
+	}
+
+	// remarshall the policy document, as in weka_s3_policy
+	policyDocument, err := json.Marshal(parsed.Data.Policy)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(policyDocument), nil
+}
+
+func setS3BucketPolicyJSON(c *WekaClient, bucket string, policy string) error {
+	var policyDocument map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &policyDocument); err != nil {
+		return err
+	}
+
+	updateBody, err := json.Marshal(map[string]interface{}{
+		"policy_file_content": policyDocument,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("/s3/buckets/%s/policyJson", bucket))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
 func resourceS3BucketDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	c := m.(*WekaClient)
@@ -152,8 +332,8 @@ func resourceS3BucketDelete(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	if _, err := c.makeRequest(req); err != nil {
-		return diag.FromErr(err)
+	if _, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutDelete)); err != nil && !isNotFoundError(err) {
+		return wekaDiags(err, nil)
 	}
 
 	d.SetId("")
@@ -174,7 +354,7 @@ func resourceS3BucketUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	// quota change
 	if d.HasChange("hard_quota") {
 		updateData := map[string]interface{}{
-			"hard_quota": d.Get("hard_quota").(string),
+			"hard_quota": normalizeS3BucketQuota(d.Get("hard_quota").(string)),
 		}
 
 		updateBody, err := json.Marshal(updateData)
@@ -185,15 +365,25 @@ func resourceS3BucketUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 		url := c.makeRestEndpointURL(fmt.Sprintf("/s3/buckets/%s/quota", id))
 		req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
-		_, err = c.makeRequest(req)
+		_, err = c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	// custom policy change, removing the custom policy falls back to
+	// the anonymous policy below.
+	policy_json := d.Get("policy_json").(string)
+
+	if d.HasChange("policy_json") && policy_json != "" {
+		if err := setS3BucketPolicyJSON(c, id, policy_json); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// policy change
-	if d.HasChange("anonymous_policy_name") {
+	if policy_json == "" && d.HasChanges("anonymous_policy_name", "policy_json") {
 		// tell me - why is it `policy` in the create call and
 		// `bucket_policy` in the update?
 		updateData := map[string]interface{}{
@@ -208,7 +398,7 @@ func resourceS3BucketUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 		url := c.makeRestEndpointURL(fmt.Sprintf("/s3/buckets/%s/policy", id))
 		req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
-		_, err = c.makeRequest(req)
+		_, err = c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
 			return diag.FromErr(err)
@@ -222,7 +412,6 @@ func resourceS3BucketUpdate(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceS3BucketCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	c := m.(*WekaClient)
 
 	createParams := make(map[string]interface{})
@@ -232,7 +421,7 @@ func resourceS3BucketCreate(ctx context.Context, d *schema.ResourceData, m inter
 	createParams["bucket_name"] = d.Get("bucket_name").(string)
 
 	if d.HasChange("hard_quota") {
-		createParams["hard_quota"] = d.Get("hard_quota").(string)
+		createParams["hard_quota"] = normalizeS3BucketQuota(d.Get("hard_quota").(string))
 	}
 
 	createParams["fs_uid"] = d.Get("fs_uid").(string)
@@ -260,10 +449,17 @@ func resourceS3BucketCreate(ctx context.Context, d *schema.ResourceData, m inter
 	// return data from creating the bucket, makeRequest will handle
 	// the common error scenarios
 	if err != nil {
-		return diag.FromErr(err)
+		return wekaDiags(err, map[string]string{wekaErrNameExists: "bucket_name", wekaErrCapacity: "hard_quota"})
 	}
 
 	d.SetId(d.Get("bucket_name").(string))
 
-	return diags
+	// custom policies can only be set once the bucket exists
+	if policy_json := d.Get("policy_json").(string); policy_json != "" {
+		if err := setS3BucketPolicyJSON(c, d.Id(), policy_json); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readAfterCreate(ctx, d, m, resourceS3BucketRead)
 }
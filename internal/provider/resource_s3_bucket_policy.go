@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceS3BucketPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Attaches an existing S3 policy to a bucket as its bucket policy. The bucket should not also set policy_json or anonymous_policy_name. Destroying the resource sets the bucket's anonymous policy back to none.",
+		ReadContext:   resourceS3BucketPolicyRead,
+		CreateContext: resourceS3BucketPolicyCreate,
+		DeleteContext: resourceS3BucketPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceS3BucketPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"s3_policy_name": {
+				Description: "Name of the weka_s3_policy to attach.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceS3BucketPolicyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected bucket_name:s3_policy_name", d.Id())
+	}
+
+	d.Set("bucket_name", parts[0])
+	d.Set("s3_policy_name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// weka has no notion of a named policy on a bucket, only the policy
+// document itself, so the attachment is still in place as long as the
+// bucket's policy matches the named policy.
+func resourceS3BucketPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	parts := strings.SplitN(d.Id(), ":", 2)
+	bucket, name := parts[0], parts[1]
+
+	policy, err := getS3BucketPolicy(c, bucket)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// one of the presets, so our policy has been replaced.
+	switch policy {
+	case "none", "download", "upload", "public":
+		d.SetId("")
+		return diags
+	}
+
+	bucketDocument, err := getS3BucketPolicyJSON(c, bucket)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyDocument, err := getS3PolicyDocument(c, name)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if equivalent, err := awspolicy.PoliciesAreEquivalent(bucketDocument, policyDocument); err != nil || !equivalent {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("bucket_name", bucket)
+	d.Set("s3_policy_name", name)
+
+	return diags
+}
+
+func resourceS3BucketPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	updateBody, err := json.Marshal(map[string]interface{}{
+		"bucket_policy": "none",
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("/s3/buckets/%s/policy", d.Get("bucket_name").(string)))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceS3BucketPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	bucket := d.Get("bucket_name").(string)
+	name := d.Get("s3_policy_name").(string)
+
+	policyDocument, err := getS3PolicyDocument(c, name)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setS3BucketPolicyJSON(c, bucket, policyDocument); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", bucket, name))
+
+	return diags
+}
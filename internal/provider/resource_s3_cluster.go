@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceS3Cluster() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the S3 service in Weka. Destroying the resource stops the S3 service.",
+		ReadContext:   resourceS3ClusterRead,
+		CreateContext: resourceS3ClusterCreate,
+		UpdateContext: resourceS3ClusterUpdate,
+		DeleteContext: resourceS3ClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"default_fs_name": {
+				Description: "Filesystem new buckets are created in by default.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"config_fs_name": {
+				Description: "Filesystem used to store the S3 service configuration.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  9000,
+			},
+			"hosts": {
+				Description:  "IDs of the containers running the S3 service.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"hosts", "all_hosts"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"all_hosts": {
+				Description: "Run the S3 service on all frontend containers.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"domain": {
+				Description: "Domain for virtual-hosted style bucket access.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// fields sent on both create and update
+func s3ClusterParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"port":   d.Get("port").(int),
+		"domain": d.Get("domain").(string),
+	}
+
+	if d.Get("all_hosts").(bool) {
+		params["all_hosts"] = true
+	} else {
+		hosts := []string{}
+		for _, h := range d.Get("hosts").(*schema.Set).List() {
+			hosts = append(hosts, h.(string))
+		}
+		params["hosts"] = hosts
+	}
+
+	return params
+}
+
+func resourceS3ClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getS3Cluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the S3 service was stopped outside of terraform.
+	if !cluster.Data.Active {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("default_fs_name", cluster.Data.Filesystem)
+	d.Set("config_fs_name", cluster.Data.ConfigFsName)
+	d.Set("port", cluster.Data.Port)
+	d.Set("domain", cluster.Data.Domain)
+	d.Set("status", cluster.Data.Status)
+
+	if cluster.Data.AllHosts {
+		d.Set("all_hosts", true)
+	} else {
+		d.Set("hosts", cluster.Data.Hosts)
+	}
+
+	return diags
+}
+
+func resourceS3ClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("s3")
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceS3ClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	updateBody, err := json.Marshal(s3ClusterParams(d))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("s3")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return diags
+}
+
+func resourceS3ClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := s3ClusterParams(d)
+	createData["default_fs_name"] = d.Get("default_fs_name").(string)
+
+	if v := d.Get("config_fs_name").(string); v != "" {
+		createData["config_fs_name"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("s3")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("s3")
+
+	return diags
+}
@@ -72,6 +72,11 @@ func resourceS3PolicyRead(ctx context.Context, d *schema.ResourceData, m interfa
 	body, err := c.makeRequest(req)
 
 	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
 		return diag.FromErr(err)
 	}
 
@@ -105,7 +110,7 @@ func resourceS3PolicyDelete(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	if _, err := c.makeRequest(req); err != nil {
+	if _, err := c.makeRequest(req); err != nil && !isNotFoundError(err) {
 		return diag.FromErr(err)
 	}
 
@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceS3TLSCertificate() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the TLS certificate of the S3 endpoint, separate from the management API certificate. Destroying the resource reverts S3 to the cluster certificate.",
+		ReadContext:   resourceS3TLSCertificateRead,
+		CreateContext: resourceS3TLSCertificateCreate,
+		UpdateContext: resourceS3TLSCertificateUpdate,
+		DeleteContext: resourceS3TLSCertificateDelete,
+		CustomizeDiff: resourceS3TLSCertificateCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Description: "PEM encoded certificate, optionally followed by its intermediates.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"private_key": {
+				Description: "PEM encoded private key for the certificate.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"ca_chain": {
+				Description: "PEM encoded CA chain, ending with the root, used to validate the certificate before it's uploaded.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"fingerprint": {
+				Description: "SHA-256 fingerprint of the certificate served by the S3 endpoint.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"not_after": {
+				Description: "Expiry time of the certificate, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaS3TLS struct {
+	Data struct {
+		Certificate string `json:"certificate"`
+	} `json:"data"`
+}
+
+// catch bad certificates, mismatched keys and broken chains at plan
+// time rather than half way through an apply.
+func resourceS3TLSCertificateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// values may not be known yet if they come from other resources
+	if !d.NewValueKnown("certificate") || !d.NewValueKnown("private_key") || !d.NewValueKnown("ca_chain") {
+		return nil
+	}
+
+	_, err := validateCertificateChain(
+		d.Get("certificate").(string),
+		d.Get("private_key").(string),
+		d.Get("ca_chain").(string),
+	)
+
+	return err
+}
+
+func resourceS3TLSCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("s3/tls")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaS3TLS
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the S3 service is back on the cluster certificate.
+	if strings.TrimSpace(parsed.Data.Certificate) == "" {
+		d.SetId("")
+		return diags
+	}
+
+	certs, err := parsePEMCertificates(parsed.Data.Certificate)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the private key is never returned, so drift is detected by
+	// comparing the served certificate with ours.
+	fingerprint := certificateFingerprint(certs[0])
+
+	if configured, err := parsePEMCertificates(d.Get("certificate").(string)); err != nil || certificateFingerprint(configured[0]) != fingerprint {
+		d.Set("certificate", parsed.Data.Certificate)
+	}
+
+	d.Set("fingerprint", fingerprint)
+	d.Set("not_after", certs[0].NotAfter.Format(time.RFC3339))
+
+	return diags
+}
+
+func resourceS3TLSCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("s3/tls")
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceS3TLSCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceS3TLSCertificateCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceS3TLSCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"certificate": d.Get("certificate").(string),
+		"private_key": d.Get("private_key").(string),
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("s3/tls")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("s3_tls")
+
+	return resourceS3TLSCertificateRead(ctx, d, m)
+}
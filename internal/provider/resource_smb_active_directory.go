@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSMBActiveDirectory() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Joins the SMB cluster to Active Directory. The SMB cluster must already be configured with the domain. The credentials are only used to join and leave the domain, they are not stored by Weka and changing them will not rejoin the domain.",
+		ReadContext:   resourceSMBActiveDirectoryRead,
+		CreateContext: resourceSMBActiveDirectoryCreate,
+		UpdateContext: resourceSMBActiveDirectoryUpdate,
+		DeleteContext: resourceSMBActiveDirectoryDelete,
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Description: "Active Directory user with permission to join computers to the domain.",
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.EnvDefaultFunc("WEKA_AD_USERNAME", nil),
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("WEKA_AD_PASSWORD", nil),
+			},
+			"server": {
+				Description: "Domain controller to join through, defaults to one discovered through DNS.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"computers_org_unit": {
+				Description: "Organizational unit to create the computer account in, e.g `Computers`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"leave_on_destroy": {
+				Description: "Leave the domain when the resource is destroyed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"domain": {
+				Description: "Domain the SMB cluster is joined to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSMBActiveDirectoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getSMBCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the cluster left the domain outside of terraform.
+	if !cluster.Data.ActiveDirectory {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("domain", cluster.Data.Domain)
+
+	return diags
+}
+
+func resourceSMBActiveDirectoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	if d.Get("leave_on_destroy").(bool) {
+		leaveBody, err := json.Marshal(map[string]interface{}{
+			"username": d.Get("username").(string),
+			"password": d.Get("password").(string),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		url := c.makeRestEndpointURL("smb/activeDirectory")
+		req, err := http.NewRequest("DELETE", url.String(), bytes.NewBuffer(leaveBody))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := c.makeRequest(req); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// Do Nothing. The credentials and leave_on_destroy are only used when
+// joining or leaving, there's nothing to send to weka.
+func resourceSMBActiveDirectoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceSMBActiveDirectoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	joinData := map[string]interface{}{
+		"username": d.Get("username").(string),
+		"password": d.Get("password").(string),
+	}
+
+	if v := d.Get("server").(string); v != "" {
+		joinData["server"] = v
+	}
+
+	if v := d.Get("computers_org_unit").(string); v != "" {
+		joinData["computers_org_unit"] = v
+	}
+
+	joinBody, err := json.Marshal(joinData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("smb/activeDirectory")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(joinBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("smb_active_directory")
+
+	// the join can take a moment to show up on the SMB cluster.
+	return readAfterCreate(ctx, d, m, resourceSMBActiveDirectoryRead)
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSMBCluster() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the SMB cluster in Weka. The stack can be either `smbw`, available from Weka 4.0 and which requires config_fs_name, or the `legacy` SMB implementation.",
+		ReadContext:   resourceSMBClusterRead,
+		CreateContext: resourceSMBClusterCreate,
+		UpdateContext: resourceSMBClusterUpdate,
+		DeleteContext: resourceSMBClusterDelete,
+		CustomizeDiff: resourceSMBClusterValidateStack,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "NetBIOS name of the SMB cluster.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_netbios_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"stack": {
+				Description:  "Must be one of: smbw or legacy. Changing the stack forces a new SMB cluster.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "smbw",
+				ValidateFunc: validation.StringInSlice([]string{"smbw", "legacy"}, false),
+			},
+			"config_fs_name": {
+				Description: "Filesystem used to store the SMB-W configuration, required when stack is smbw and not supported by the legacy stack.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"hosts": {
+				Description: "IDs of the containers participating in the SMB cluster.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ips": {
+				Description: "Floating IPs of the SMB cluster, e.g `10.0.0.10-10.0.0.20`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"encryption": {
+				Description:  "Must be one of: enabled, disabled, desired or required.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: validation.StringInSlice([]string{"enabled", "disabled", "desired", "required"}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// config_fs_name is required by smbw and unsupported by legacy, and
+// smbw needs weka 4 or later.
+func resourceSMBClusterValidateStack(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("stack") || !d.NewValueKnown("config_fs_name") {
+		return nil
+	}
+
+	stack := d.Get("stack").(string)
+	config_fs_name := d.Get("config_fs_name").(string)
+
+	if stack == "legacy" && config_fs_name != "" {
+		return fmt.Errorf("config_fs_name is not supported by the legacy SMB stack")
+	}
+
+	if stack != "smbw" {
+		return nil
+	}
+
+	if config_fs_name == "" {
+		return fmt.Errorf("config_fs_name is required when stack is smbw")
+	}
+
+	c, ok := m.(*WekaClient)
+
+	if !ok || c == nil {
+		return nil
+	}
+
+	cluster, err := getCluster(c)
+
+	if err != nil {
+		return err
+	}
+
+	if major := cluster.majorVersion(); major != 0 && major < 4 {
+		return fmt.Errorf("the smbw stack requires Weka 4.0 or later, cluster is running %s", cluster.Data.Release)
+	}
+
+	return nil
+}
+
+func resourceSMBClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	cluster, err := getSMBCluster(c)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// no SMB cluster configured any more.
+	if cluster.Data.ClusterName == "" {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("name", cluster.Data.ClusterName)
+	d.Set("domain", cluster.Data.Domain)
+	d.Set("domain_netbios_name", cluster.Data.DomainNetbiosName)
+	d.Set("encryption", cluster.Data.Encryption)
+	d.Set("hosts", cluster.Data.Hosts)
+	d.Set("status", cluster.Data.Status)
+
+	if cluster.Data.SmbwEnabled {
+		d.Set("stack", "smbw")
+		d.Set("config_fs_name", cluster.Data.SmbConfFsName)
+	} else {
+		d.Set("stack", "legacy")
+	}
+
+	return diags
+}
+
+func resourceSMBClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("smb")
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func smbClusterHosts(d *schema.ResourceData) []string {
+	hosts := []string{}
+	for _, h := range d.Get("hosts").(*schema.Set).List() {
+		hosts = append(hosts, h.(string))
+	}
+	return hosts
+}
+
+func resourceSMBClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	updateData := make(map[string]interface{})
+
+	if d.HasChange("encryption") {
+		updateData["encryption"] = d.Get("encryption").(string)
+	}
+
+	if d.HasChange("hosts") {
+		updateData["hosts"] = smbClusterHosts(d)
+	}
+
+	if d.HasChange("ips") {
+		updateData["smb_ips_pool"] = d.Get("ips").([]interface{})
+	}
+
+	updateBody, err := json.Marshal(updateData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("smb")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceSMBClusterRead(ctx, d, m)
+}
+
+func resourceSMBClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"cluster_name": d.Get("name").(string),
+		"domain":       d.Get("domain").(string),
+		"encryption":   d.Get("encryption").(string),
+		"hosts":        smbClusterHosts(d),
+		"smb_ips_pool": d.Get("ips").([]interface{}),
+		"smbw":         d.Get("stack").(string) == "smbw",
+	}
+
+	if v := d.Get("domain_netbios_name").(string); v != "" {
+		createData["domain_netbios_name"] = v
+	}
+
+	if v := d.Get("config_fs_name").(string); v != "" {
+		createData["config_fs_name"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("smb")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return diags
+}
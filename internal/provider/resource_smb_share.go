@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// user lists on a share, keyed by attribute name, mapping to the list
+// type in the weka API.
+var smbShareUserLists = map[string]string{
+	"valid_users":      "valid",
+	"invalid_users":    "invalid",
+	"read_only_users":  "read_only",
+	"read_write_users": "read_write",
+}
+
+// users and groups are compared case-insensitively by AD, so keep the
+// case we already have for any user weka returns in a different case,
+// otherwise the difference would show up as a diff that never applies.
+func smbUserList(d *schema.ResourceData, attr string, users []string) []string {
+	known := map[string]string{}
+
+	for _, u := range d.Get(attr).(*schema.Set).List() {
+		known[strings.ToLower(u.(string))] = u.(string)
+	}
+
+	list := []string{}
+
+	for _, u := range users {
+		if k, ok := known[strings.ToLower(u)]; ok {
+			u = k
+		}
+
+		list = append(list, u)
+	}
+
+	return list
+}
+
+func smbUserListSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description + " Groups are prefixed with `@`, e.g `@DOMAIN\\admins`.",
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceSMBShare() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages SMB shares in Weka, including share level ACLs and the lists of users and groups allowed to access them.",
+		ReadContext:   resourceSMBShareRead,
+		CreateContext: resourceSMBShareCreate,
+		UpdateContext: resourceSMBShareUpdate,
+		DeleteContext: resourceSMBShareDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"share_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fs_name": {
+				Description: "Name of the filesystem to share.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"internal_path": {
+				Description: "Path within the filesystem to share.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "/",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"acl": {
+				Description: "Enable Windows ACLs on the share.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"read_only": {
+				Description: "Mount the share read-only for everyone not in read_write_users.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"valid_users":      smbUserListSchema("Only these users and groups can access the share."),
+			"invalid_users":    smbUserListSchema("These users and groups are denied access to the share."),
+			"read_only_users":  smbUserListSchema("These users and groups only get read access to the share."),
+			"read_write_users": smbUserListSchema("These users and groups get read and write access to the share."),
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaSMBShare struct {
+	Data struct {
+		UID            string   `json:"uid"`
+		ID             int      `json:"id"`
+		ShareName      string   `json:"share_name"`
+		FsName         string   `json:"fs_name"`
+		InternalPath   string   `json:"internal_path"`
+		Description    string   `json:"description"`
+		Acl            bool     `json:"acl"`
+		ReadOnly       bool     `json:"read_only"`
+		ValidUsers     []string `json:"valid_users"`
+		InvalidUsers   []string `json:"invalid_users"`
+		ReadOnlyUsers  []string `json:"read_only_users"`
+		ReadWriteUsers []string `json:"read_write_users"`
+	} `json:"data"`
+}
+
+func extractSMBShareJsonData(body []byte, d *schema.ResourceData) error {
+	var share WekaSMBShare
+
+	if err := json.Unmarshal(body, &share); err != nil {
+		return err
+	}
+
+	d.SetId(share.Data.UID)
+	d.Set("share_name", share.Data.ShareName)
+	d.Set("fs_name", share.Data.FsName)
+	d.Set("internal_path", share.Data.InternalPath)
+	d.Set("description", share.Data.Description)
+	d.Set("acl", share.Data.Acl)
+	d.Set("read_only", share.Data.ReadOnly)
+	d.Set("valid_users", smbUserList(d, "valid_users", share.Data.ValidUsers))
+	d.Set("invalid_users", smbUserList(d, "invalid_users", share.Data.InvalidUsers))
+	d.Set("read_only_users", smbUserList(d, "read_only_users", share.Data.ReadOnlyUsers))
+	d.Set("read_write_users", smbUserList(d, "read_write_users", share.Data.ReadWriteUsers))
+
+	return nil
+}
+
+func modifySMBShareUserList(c *WekaClient, method string, uid string, listType string, users []interface{}) error {
+	listBody, err := json.Marshal(map[string]interface{}{
+		"users": users,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("smb/shares/%s/lists/%s", uid, listType))
+	req, err := http.NewRequest(method, url.String(), bytes.NewBuffer(listBody))
+
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(req)
+
+	return err
+}
+
+// the user lists are managed through their own API calls, so add and
+// remove whatever changed.
+func updateSMBShareUserLists(c *WekaClient, d *schema.ResourceData) error {
+	for attr, listType := range smbShareUserLists {
+		if !d.HasChange(attr) {
+			continue
+		}
+
+		o, n := d.GetChange(attr)
+		removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		if len(removed) > 0 {
+			if err := modifySMBShareUserList(c, "DELETE", d.Id(), listType, removed); err != nil {
+				return err
+			}
+		}
+
+		if len(added) > 0 {
+			if err := modifySMBShareUserList(c, "POST", d.Id(), listType, added); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceSMBShareRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	url := c.makeRestEndpointURL(fmt.Sprintf("smb/shares/%s", id))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if err := extractSMBShareJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSMBShareDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	id := d.Id()
+	url := c.makeRestEndpointURL(fmt.Sprintf("smb/shares/%s", id))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutDelete)); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceSMBShareUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	// enable partial state since we could be making several API calls for these changes
+	d.Partial(true)
+
+	if d.HasChanges("description", "acl", "read_only") {
+		updateBody, err := json.Marshal(map[string]interface{}{
+			"description": d.Get("description").(string),
+			"acl":         d.Get("acl").(bool),
+			"read_only":   d.Get("read_only").(bool),
+		})
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		url := c.makeRestEndpointURL(fmt.Sprintf("smb/shares/%s", d.Id()))
+		req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if _, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateSMBShareUserLists(c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Partial(false)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return resourceSMBShareRead(ctx, d, m)
+}
+
+func resourceSMBShareCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	createBody, err := json.Marshal(map[string]interface{}{
+		"share_name":    d.Get("share_name").(string),
+		"fs_name":       d.Get("fs_name").(string),
+		"internal_path": d.Get("internal_path").(string),
+		"description":   d.Get("description").(string),
+		"acl":           d.Get("acl").(bool),
+		"read_only":     d.Get("read_only").(bool),
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("smb/shares")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return wekaDiags(err, map[string]string{wekaErrNameExists: "share_name"})
+	}
+
+	var share WekaSMBShare
+
+	if err := json.Unmarshal(body, &share); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(share.Data.UID)
+
+	if err := updateSMBShareUserLists(c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSMBShareRead(ctx, d, m)
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSMTP() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the SMTP server alert emails are sent through, and their recipients. Destroying the resource removes the SMTP configuration. The password is not returned by the Weka API, so remote changes to it will not be detected.",
+		ReadContext:   resourceSMTPRead,
+		CreateContext: resourceSMTPCreate,
+		UpdateContext: resourceSMTPUpdate,
+		DeleteContext: resourceSMTPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Description: "Hostname or IP of the SMTP server.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      587,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"use_tls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"username"},
+			},
+			"sender_email": {
+				Description: "Address alert emails are sent from.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"recipients": {
+				Description: "Addresses alert emails are sent to.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaSMTP struct {
+	Data struct {
+		Host        string   `json:"smtp_host"`
+		Port        int      `json:"smtp_port"`
+		UseTLS      bool     `json:"smtp_use_tls"`
+		Username    string   `json:"smtp_username"`
+		SenderEmail string   `json:"sender_email"`
+		Recipients  []string `json:"recipients"`
+	} `json:"data"`
+}
+
+func resourceSMTPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("smtp")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaSMTP
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// SMTP was removed outside of terraform.
+	if parsed.Data.Host == "" {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("host", parsed.Data.Host)
+	d.Set("port", parsed.Data.Port)
+	d.Set("use_tls", parsed.Data.UseTLS)
+	d.Set("username", parsed.Data.Username)
+	d.Set("sender_email", parsed.Data.SenderEmail)
+	d.Set("recipients", parsed.Data.Recipients)
+
+	return diags
+}
+
+func resourceSMTPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("smtp")
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceSMTPUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceSMTPCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceSMTPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	recipients := []string{}
+	for _, r := range d.Get("recipients").(*schema.Set).List() {
+		recipients = append(recipients, r.(string))
+	}
+
+	createData := map[string]interface{}{
+		"smtp_host":    d.Get("host").(string),
+		"smtp_port":    d.Get("port").(int),
+		"smtp_use_tls": d.Get("use_tls").(bool),
+		"sender_email": d.Get("sender_email").(string),
+		"recipients":   recipients,
+	}
+
+	if v := d.Get("username").(string); v != "" {
+		createData["smtp_username"] = v
+		createData["smtp_password"] = d.Get("password").(string)
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("smtp")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("smtp")
+
+	return diags
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages filesystem snapshots in Weka. Only the name and access point of a snapshot can be changed, any other change will delete and recreate the snapshot.",
+		ReadContext:   resourceSnapshotRead,
+		CreateContext: resourceSnapshotCreate,
+		UpdateContext: resourceSnapshotUpdate,
+		DeleteContext: resourceSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"fs_uid": {
+				Description: "UID of the filesystem to snapshot.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"access_point": {
+				Description: "Name of the directory the snapshot is accessible from under .snapshots, defaults to a name generated by Weka from the creation time.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					v := val.(string)
+
+					if strings.Contains(v, "/") {
+						errs = append(errs, fmt.Errorf("%q cannot contain a '/', got: %s", key, v))
+					}
+
+					return
+				},
+			},
+			"gmt_access_point": {
+				Description:   "Name the access point in the `@GMT-YYYY.MM.DD-HH.MM.SS` format, using the time the snapshot is created, so it shows up in SMB previous versions. Cannot be used with access_point.",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"access_point"},
+			},
+			"path": {
+				Description: "Path of the snapshot relative to the root of the filesystem, e.g `/.snapshots/@GMT-2023.01.01-00.00.00`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_writable": {
+				Description: "Create a writable snapshot.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"source_snapshot_uid": {
+				Description: "Create the snapshot as a clone of an existing snapshot, rather than from the live filesystem.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaSnapshotData struct {
+	UID          string `json:"uid"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Filesystem   string `json:"filesystem"`
+	FsUID        string `json:"fs_uid"`
+	AccessPoint  string `json:"access_point"`
+	Locator      string `json:"locator"`
+	IsWritable   bool   `json:"is_writable"`
+	IsRemoving   bool   `json:"is_removing"`
+	CreationTime string `json:"creationTime"`
+}
+
+type WekaSnapshot struct {
+	Data WekaSnapshotData `json:"data"`
+}
+
+func extractSnapshotJsonData(body []byte, d *schema.ResourceData) error {
+	var snap WekaSnapshot
+
+	if err := json.Unmarshal(body, &snap); err != nil {
+		return err
+	}
+
+	d.SetId(snap.Data.UID)
+	d.Set("name", snap.Data.Name)
+	d.Set("access_point", snap.Data.AccessPoint)
+	d.Set("path", path.Join("/.snapshots", snap.Data.AccessPoint))
+	d.Set("is_writable", snap.Data.IsWritable)
+	d.Set("creation_time", snap.Data.CreationTime)
+
+	if snap.Data.FsUID != "" {
+		d.Set("fs_uid", snap.Data.FsUID)
+	}
+
+	return nil
+}
+
+func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	url := c.makeRestEndpointURL(fmt.Sprintf("snapshots/%s", id))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if err := extractSnapshotJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	id := d.Id()
+	url := c.makeRestEndpointURL(fmt.Sprintf("snapshots/%s", id))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutDelete)); err != nil && !isNotFoundError(err) {
+		return wekaDiags(err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	updateData := make(map[string]interface{})
+
+	if d.HasChange("name") {
+		updateData["new_name"] = d.Get("name").(string)
+	}
+
+	if d.HasChange("access_point") {
+		updateData["access_point"] = d.Get("access_point").(string)
+	}
+
+	updateBody, err := json.Marshal(updateData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("snapshots/%s", d.Id()))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := extractSnapshotJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return diags
+}
+
+func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"fs_uid":      d.Get("fs_uid").(string),
+		"name":        d.Get("name").(string),
+		"is_writable": d.Get("is_writable").(bool),
+	}
+
+	if d.HasChange("access_point") {
+		createData["access_point"] = d.Get("access_point").(string)
+	}
+
+	if d.Get("gmt_access_point").(bool) {
+		createData["access_point"] = time.Now().UTC().Format("@GMT-2006.01.02-15.04.05")
+	}
+
+	if d.HasChange("source_snapshot_uid") {
+		createData["source_snap_uid"] = d.Get("source_snapshot_uid").(string)
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("snapshots")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return wekaDiags(err, map[string]string{wekaErrNameExists: "name"})
+	}
+
+	if err := extractSnapshotJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceSnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Restores a filesystem to a snapshot, overwriting the live filesystem, so confirm must be set to true. The restore runs on create and whenever an argument or trigger changes, destroying the resource does nothing.",
+		ReadContext:   resourceSnapshotRestoreRead,
+		CreateContext: resourceSnapshotRestoreCreate,
+		DeleteContext: resourceSnapshotRestoreDelete,
+		Schema: map[string]*schema.Schema{
+			"fs_uid": {
+				Description: "UID of the filesystem to restore.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"snapshot_name": {
+				Description: "Name of the snapshot to restore the filesystem to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"preserve_snapshot_name": {
+				Description: "If set, a snapshot of the filesystem is taken with this name before it is overwritten.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"confirm": {
+				Description: "Must be true, restoring a snapshot overwrites the current contents of the filesystem.",
+				Type:        schema.TypeBool,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					if !val.(bool) {
+						errs = append(errs, fmt.Errorf("%q must be true, restoring a snapshot overwrites the current contents of the filesystem", key))
+					}
+
+					return
+				},
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, will run the restore again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// Do Nothing. The restore is a one off action, there is nothing to read.
+func resourceSnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	return diags
+}
+
+// Do Nothing. A restore cannot be undone.
+func resourceSnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	d.SetId("")
+	return diags
+}
+
+func resourceSnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	// confirm is validated at plan time, this catches a value that
+	// wasn't known until apply.
+	if !d.Get("confirm").(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "confirm must be true to restore a filesystem from a snapshot",
+			Detail:   "restoring a snapshot overwrites the current contents of the filesystem.",
+		})
+		return diags
+	}
+
+	createData := map[string]interface{}{
+		"source_name": d.Get("snapshot_name").(string),
+	}
+
+	if v := d.Get("preserve_snapshot_name").(string); v != "" {
+		createData["preserved_overwritten_snapshot_name"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("fileSystems/%s/restore", d.Get("fs_uid").(string)))
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
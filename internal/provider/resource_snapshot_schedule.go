@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSnapshotSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages scheduled snapshot policies in Weka. Snapshots are taken of every filesystem in the policy either every interval_minutes or on a cron schedule, keeping the most recent retention snapshots.",
+		ReadContext:   resourceSnapshotScheduleRead,
+		CreateContext: resourceSnapshotScheduleCreate,
+		UpdateContext: resourceSnapshotScheduleUpdate,
+		DeleteContext: resourceSnapshotScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"interval_minutes": {
+				Description:  "Take a snapshot every this many minutes.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"interval_minutes", "cron"},
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cron": {
+				Description: "Take snapshots on a cron schedule, e.g `0 2 * * *`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"retention": {
+				Description:  "Number of snapshots to keep for each filesystem.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"filesystems": {
+				Description: "UIDs of the filesystems covered by the schedule.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaSnapshotSchedule struct {
+	Data struct {
+		UID         string `json:"uid"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		IsEnabled   bool   `json:"is_enabled"`
+		Schedule    struct {
+			IntervalMinutes int    `json:"interval_minutes"`
+			Cron            string `json:"cron"`
+		} `json:"schedule"`
+		Retention   int      `json:"retention"`
+		Filesystems []string `json:"filesystems"`
+	} `json:"data"`
+}
+
+func extractSnapshotScheduleJsonData(body []byte, d *schema.ResourceData) error {
+	var sched WekaSnapshotSchedule
+
+	if err := json.Unmarshal(body, &sched); err != nil {
+		return err
+	}
+
+	d.SetId(sched.Data.UID)
+	d.Set("name", sched.Data.Name)
+	d.Set("description", sched.Data.Description)
+	d.Set("enabled", sched.Data.IsEnabled)
+	d.Set("retention", sched.Data.Retention)
+	d.Set("filesystems", sched.Data.Filesystems)
+
+	if sched.Data.Schedule.Cron != "" {
+		d.Set("cron", sched.Data.Schedule.Cron)
+	} else {
+		d.Set("interval_minutes", sched.Data.Schedule.IntervalMinutes)
+	}
+
+	return nil
+}
+
+// weka takes the whole policy on both create and update
+func snapshotScheduleParams(d *schema.ResourceData) map[string]interface{} {
+	schedule := make(map[string]interface{})
+
+	if v := d.Get("cron").(string); v != "" {
+		schedule["cron"] = v
+	} else {
+		schedule["interval_minutes"] = d.Get("interval_minutes").(int)
+	}
+
+	filesystems := []string{}
+	for _, fs := range d.Get("filesystems").(*schema.Set).List() {
+		filesystems = append(filesystems, fs.(string))
+	}
+
+	return map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"is_enabled":  d.Get("enabled").(bool),
+		"schedule":    schedule,
+		"retention":   d.Get("retention").(int),
+		"filesystems": filesystems,
+	}
+}
+
+func resourceSnapshotScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	id := d.Id()
+	url := c.makeRestEndpointURL(fmt.Sprintf("snapshotPolicy/%s", id))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return diag.FromErr(err)
+	}
+
+	if err := extractSnapshotScheduleJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSnapshotScheduleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	id := d.Id()
+	url := c.makeRestEndpointURL(fmt.Sprintf("snapshotPolicy/%s", id))
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutDelete)); err != nil && !isNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceSnapshotScheduleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	updateBody, err := json.Marshal(snapshotScheduleParams(d))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL(fmt.Sprintf("snapshotPolicy/%s", d.Id()))
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(updateBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequestWhenIdle(ctx, req, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := extractSnapshotScheduleJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+
+	return diags
+}
+
+func resourceSnapshotScheduleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createBody, err := json.Marshal(snapshotScheduleParams(d))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("snapshotPolicy")
+	req, err := http.NewRequest("POST", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := extractSnapshotScheduleJsonData(body, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSyslog() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Forwards cluster events to a remote syslog server. Destroying the resource stops forwarding.",
+		ReadContext:   resourceSyslogRead,
+		CreateContext: resourceSyslogCreate,
+		UpdateContext: resourceSyslogUpdate,
+		DeleteContext: resourceSyslogDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Description: "Hostname or IP of the syslog server.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      514,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Description:  "Must be one of: udp or tcp.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "udp",
+				ValidateFunc: validation.StringInSlice([]string{"udp", "tcp"}, false),
+			},
+			"min_severity": {
+				Description:  "Only forward events of at least this severity, must be one of: INFO, MINOR, MAJOR or CRITICAL.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "INFO",
+				ValidateFunc: validation.StringInSlice([]string{"INFO", "MINOR", "MAJOR", "CRITICAL"}, false),
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaSyslog struct {
+	Data struct {
+		Enabled  bool   `json:"enabled"`
+		Server   string `json:"server"`
+		Port     int    `json:"port"`
+		Protocol string `json:"protocol"`
+		Severity string `json:"min_severity"`
+	} `json:"data"`
+}
+
+func resourceSyslogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("events/syslog")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaSyslog
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// forwarding was turned off outside of terraform.
+	if !parsed.Data.Enabled {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("host", parsed.Data.Server)
+	d.Set("port", parsed.Data.Port)
+	d.Set("protocol", parsed.Data.Protocol)
+
+	if parsed.Data.Severity != "" {
+		d.Set("min_severity", parsed.Data.Severity)
+	}
+
+	return diags
+}
+
+func resourceSyslogDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	url := c.makeRestEndpointURL("events/syslog")
+	req, err := http.NewRequest("DELETE", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceSyslogUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceSyslogCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceSyslogCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	createData := map[string]interface{}{
+		"enable":       true,
+		"server":       d.Get("host").(string),
+		"port":         d.Get("port").(int),
+		"protocol":     d.Get("protocol").(string),
+		"min_severity": d.Get("min_severity").(string),
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("events/syslog")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("syslog")
+
+	return diags
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTime() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the cluster's NTP servers and timezone. Destroying the resource removes the NTP servers but leaves the timezone as it is.",
+		ReadContext:   resourceTimeRead,
+		CreateContext: resourceTimeCreate,
+		UpdateContext: resourceTimeUpdate,
+		DeleteContext: resourceTimeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"ntp_servers": {
+				Description: "Hostnames or IPs of the NTP servers the cluster syncs with.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"timezone": {
+				Description: "IANA timezone of the cluster, e.g 'Europe/London'.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					// "" and "Local" are valid for LoadLocation but mean the
+					// timezone of wherever terraform runs, not an IANA name.
+					if v := val.(string); v == "" || v == "Local" {
+						errs = append(errs, fmt.Errorf("%q must be an IANA timezone name, got %q", key, v))
+					} else if _, err := time.LoadLocation(v); err != nil {
+						errs = append(errs, err)
+					}
+					return
+				},
+			},
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type WekaTime struct {
+	Data struct {
+		NTPServers []string `json:"ntp_servers"`
+		Timezone   string   `json:"timezone"`
+	} `json:"data"`
+}
+
+func resourceTimeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+	var diags diag.Diagnostics
+
+	url := c.makeRestEndpointURL("cluster/time")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parsed WekaTime
+
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// no servers configured, they were removed outside of terraform.
+	if len(parsed.Data.NTPServers) == 0 {
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("ntp_servers", parsed.Data.NTPServers)
+	d.Set("timezone", parsed.Data.Timezone)
+
+	return diags
+}
+
+func resourceTimeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+
+	deleteBody, err := json.Marshal(map[string]interface{}{
+		"ntp_servers": []string{},
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("cluster/time")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(deleteBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceTimeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	diags := resourceTimeCreate(ctx, d, m)
+	d.Set("last_updated", time.Now().Format(time.RFC850))
+	return diags
+}
+
+func resourceTimeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*WekaClient)
+
+	ntp_servers := []string{}
+
+	for _, s := range d.Get("ntp_servers").([]interface{}) {
+		ntp_servers = append(ntp_servers, s.(string))
+	}
+
+	createData := map[string]interface{}{
+		"ntp_servers": ntp_servers,
+	}
+
+	if v := d.Get("timezone").(string); v != "" {
+		createData["timezone"] = v
+	}
+
+	createBody, err := json.Marshal(createData)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	url := c.makeRestEndpointURL("cluster/time")
+	req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(createBody))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := c.makeRequest(req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("time")
+
+	return resourceTimeRead(ctx, d, m)
+}
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -18,30 +20,61 @@ func resourceUser() *schema.Resource {
 		CreateContext: resourceUserCreate,
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
+		CustomizeDiff: resourceUserCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"username": {
-				Type:     schema.TypeString,
-				Required: true,
+				Description: "Weka has no API to rename a user, changing this will delete the user and create a new one.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Description:      "Required when creating the user. With ignore_password_changes set, this is only used at create.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: userPasswordDiff,
+			},
+			"ignore_password_changes": {
+				Description: "Only set the password when the user is created and ignore it afterwards, for passwords rotated outside of terraform. The password is not kept in state.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
 			},
 			"role": {
-				Description: "Must be one of: ClusterAdmin, OrgAdmin, ReadOnly, Regular or S3",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:      "Must be one of: ClusterAdmin, OrgAdmin, ReadOnly, Regular or S3, in any case.",
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        func(val any) string { return normalizeUserRole(val.(string)) },
+				DiffSuppressFunc: userRoleDiff,
 				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
 					v := val.(string)
 
-					if !(v == "ClusterAdmin" || v == "OrgAdmin" || v == "ReadOnly" || v == "Regular" || v == "S3") {
+					if !userRoleValid(v) {
 						errs = append(errs, fmt.Errorf("%q must be one of ClusterAdmin, OrgAdmin, ReadOnly, Regular or S3, got: %s", key, v))
 					}
 
 					return
 				},
 			},
+			"revoke_tokens_on_update": {
+				Description: "Revoke the user's existing API tokens when their password or role is changed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"enabled": {
+				Description: "Disabled users cannot log in, but keep their role and POSIX IDs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"expires_at": {
+				Description:  "Time the user is disabled at, in RFC3339 format, e.g '2025-01-31T00:00:00Z'. Requires a version of Weka that supports user expiry.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
 			"posix_uid": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -61,6 +94,48 @@ func resourceUser() *schema.Resource {
 	}
 }
 
+var userRoles = []string{"ClusterAdmin", "OrgAdmin", "ReadOnly", "Regular", "S3"}
+
+// normalizeUserRole returns the role in the casing we document, weka
+// isn't always consistent about the casing it returns.
+func normalizeUserRole(role string) string {
+	for _, r := range userRoles {
+		if strings.EqualFold(r, role) {
+			return r
+		}
+	}
+
+	return role
+}
+
+func userRoleValid(role string) bool {
+	for _, r := range userRoles {
+		if strings.EqualFold(r, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func userRoleDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// once the user exists, password changes are ignored if asked.
+func userPasswordDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get("ignore_password_changes").(bool)
+}
+
+// weka needs a password to create a user, but it's optional otherwise.
+func resourceUserCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" && d.NewValueKnown("password") && d.Get("password").(string) == "" {
+		return fmt.Errorf("password is required when creating a user")
+	}
+
+	return nil
+}
+
 type WekaUser struct {
 	Data struct {
 		UID      string `json:"uid"`
@@ -80,32 +155,76 @@ type WekaGetUsers struct {
 		Source   string `json:"source"`
 		Username string `json:"username"`
 		Role     string `json:"role"`
+		// only returned by newer versions of weka
+		PosixUID   *int    `json:"posix_uid"`
+		PosixGID   *int    `json:"posix_gid"`
+		Disabled   *bool   `json:"disabled"`
+		Expiration *string `json:"expiration"`
 	} `json:"data"`
 }
 
-// weka doesn't provide an API to get a single user, so we have to get
-// _all_ of them
-func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	c := m.(*WekaClient)
-	
-	id := d.Id()
+func getUsers(c *WekaClient) (*WekaGetUsers, error) {
 	url := c.makeRestEndpointURL("/users")
 	req, err := http.NewRequest("GET", url.String(), nil)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
 	body, err := c.makeRequest(req)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
 	var parsed WekaGetUsers
 
 	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+// getUserPosixIDs reads the POSIX IDs of a single user, older versions
+// of weka don't have this endpoint in which case ok is false.
+func getUserPosixIDs(c *WekaClient, uid string) (posix_uid int, posix_gid int, ok bool, err error) {
+	url := c.makeRestEndpointURL(fmt.Sprintf("users/%s", uid))
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		if isNotFoundError(err) {
+			return 0, 0, false, nil
+		}
+
+		return 0, 0, false, err
+	}
+
+	var user WekaUser
+
+	if err := json.Unmarshal(body, &user); err != nil {
+		return 0, 0, false, err
+	}
+
+	return user.Data.PosixUID, user.Data.PosixGID, true, nil
+}
+
+// weka doesn't provide an API to get a single user, so we have to get
+// _all_ of them
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := m.(*WekaClient)
+	
+	id := d.Id()
+	parsed, err := getUsers(c)
+
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -113,9 +232,35 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		b := parsed.Data[i]
 
 		if b.UID == id {
-			// role is the only field returned in the response that we
-			// can actually edit.
-			d.Set("role", b.Role)
+			d.Set("role", normalizeUserRole(b.Role))
+
+			if b.Disabled != nil {
+				d.Set("enabled", !*b.Disabled)
+			}
+
+			if b.Expiration != nil {
+				d.Set("expires_at", *b.Expiration)
+			}
+
+			if b.PosixUID != nil && b.PosixGID != nil {
+				d.Set("posix_uid", *b.PosixUID)
+				d.Set("posix_gid", *b.PosixGID)
+				return diags
+			}
+
+			// the list doesn't have the POSIX IDs, so try asking
+			// for the user on its own.
+			posix_uid, posix_gid, ok, err := getUserPosixIDs(c, id)
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if ok {
+				d.Set("posix_uid", posix_uid)
+				d.Set("posix_gid", posix_gid)
+			}
+
 			return diags
 		}
 	}
@@ -153,22 +298,18 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	var diags diag.Diagnostics
 	c := m.(*WekaClient)
 
-	// changes to un-updateable fields?
-	if d.HasChange("username") {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  "cannot update username",
-		})
-		return diags
-	}
-
 	// do we need to make an /users/password API call?
 	if d.HasChange("password") {
 		pud := make(map[string]interface{})
 		pud["username"] = d.Get("username")
 		op, np := d.GetChange("password")
-		pud["old_password"] = op.(string)
 		pud["new_password"] = np.(string)
+
+		// the old password isn't known if it wasn't kept in state,
+		// weka allows admins to set it without it.
+		if op.(string) != "" {
+			pud["old_password"] = op.(string)
+		}
 		pud["org"] = c.getOrg()
 
 		url := c.makeRestEndpointURL("/users/password")
@@ -194,9 +335,19 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	// API call to /users/$uid
 	if d.HasChange("posix_uid") ||
 		d.HasChange("posix_gid") ||
-		d.HasChange("role") {
+		d.HasChange("role") ||
+		d.HasChange("enabled") ||
+		d.HasChange("expires_at") {
 		ud := make(map[string]interface{})
 
+		if d.HasChange("enabled") {
+			ud["disabled"] = !d.Get("enabled").(bool)
+		}
+
+		if d.HasChange("expires_at") {
+			ud["expiration"] = d.Get("expires_at").(string)
+		}
+
 		if d.HasChange("role") {
 			ud["role"] = d.Get("role").(string)
 		}
@@ -209,9 +360,32 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 			ud["posix_gid"] = d.Get("posix_gid").(int)
 		}
 
+		ub, err := json.Marshal(ud)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		id := d.Id()
 		url := c.makeRestEndpointURL(fmt.Sprintf("users/%s", id))
-		req, err := http.NewRequest("PUT", url.String(), nil)
+		req, err := http.NewRequest("PUT", url.String(), bytes.NewBuffer(ub))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = c.makeRequest(req)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// old tokens carry the old role, and could have been obtained with
+	// the old password.
+	if d.Get("revoke_tokens_on_update").(bool) && d.HasChanges("password", "role") {
+		url := c.makeRestEndpointURL(fmt.Sprintf("users/%s/revoke", d.Id()))
+		req, err := http.NewRequest("POST", url.String(), nil)
 
 		if err != nil {
 			return diag.FromErr(err)
@@ -229,7 +403,6 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 }
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
 	c := m.(*WekaClient)
 
 	createParams := make(map[string]interface{})
@@ -242,10 +415,18 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 		createParams["posix_uid"] = d.Get("posix_uid").(int)
 	}
 
-	if d.HasChange("posix_uid") {
+	if d.HasChange("posix_gid") {
 		createParams["posix_gid"] = d.Get("posix_gid").(int)
 	}
 
+	if !d.Get("enabled").(bool) {
+		createParams["disabled"] = true
+	}
+
+	if d.HasChange("expires_at") {
+		createParams["expiration"] = d.Get("expires_at").(string)
+	}
+
 	createBody, err := json.Marshal(createParams)
 
 	if err != nil {
@@ -262,7 +443,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	body, err := c.makeRequest(req)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return wekaDiags(err, map[string]string{wekaErrNameExists: "username"})
 	}
 
 	var wekauser WekaUser
@@ -274,7 +455,11 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	d.Set("posix_uid", wekauser.Data.PosixUID)
 	d.Set("posix_gid", wekauser.Data.PosixGID)
 
+	if d.Get("ignore_password_changes").(bool) {
+		d.Set("password", "")
+	}
+
 	d.SetId(wekauser.Data.UID)
 
-	return diags
+	return readAfterCreate(ctx, d, m, resourceUserRead)
 }
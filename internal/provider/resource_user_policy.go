@@ -4,10 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"net/http"
-	"strconv"
+	"strings"
 	"time"
 )
 
@@ -18,6 +19,9 @@ func resourceUserPolicy() *schema.Resource {
 		CreateContext: resourceUserPolicyCreate,
 		UpdateContext: resourceUserPolicyUpdate,
 		DeleteContext: resourceUserPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserPolicyImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Type:     schema.TypeString,
@@ -42,6 +46,20 @@ type WekaUserPolicies struct {
 	} `json:"data"`
 }
 
+// the ID is username:s3_policy_name, older versions of this provider
+// used a timestamp, in which case the username is only in the state.
+// Either way this is the user the policy is currently attached to,
+// not the one it is changing to.
+func userPolicyUsername(d *schema.ResourceData) string {
+	if parts := strings.SplitN(d.Id(), ":", 2); len(parts) == 2 {
+		return parts[0]
+	}
+
+	o, _ := d.GetChange("username")
+
+	return o.(string)
+}
+
 // GET /s3/userPolicies will tell us if the policy is mapped or not.
 func resourceUserPolicyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -66,18 +84,24 @@ func resourceUserPolicyRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	current_policy := d.Get("s3_policy_name").(string)
+	// the username is the part we look up, the policy is whatever
+	// weka says is attached.
+	username := userPolicyUsername(d)
 
-	if policy, exists := parsed.Data.Users["username"]; exists {
-		// lgtm
-		if current_policy == policy {
-			return diags
-		}
+	if policy, exists := parsed.Data.Users[username]; exists && policy != "" {
+		d.Set("username", username)
 
 		// policy could be set to something other than we have
-		// defined, or it could be empty "", in which case let
-		// terraform deal with the difference
+		// defined, in which case let terraform deal with the
+		// difference
 		d.Set("s3_policy_name", policy)
+
+		// rewrite IDs from older versions of this provider.
+		if !strings.Contains(d.Id(), ":") {
+			d.SetId(fmt.Sprintf("%s:%s", username, policy))
+		}
+
+		return diags
 	}
 
 	// no policy attached to this user, or user does not exist.
@@ -85,12 +109,27 @@ func resourceUserPolicyRead(ctx context.Context, d *schema.ResourceData, m inter
 	return diags
 }
 
+func resourceUserPolicyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected username:s3_policy_name", d.Id())
+	}
+
+	d.Set("username", parts[0])
+	d.Set("s3_policy_name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceUserPolicyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	c := m.(*WekaClient)
 
 	delDoc := make(map[string]interface{})
-	delDoc["user_name"] = d.Get("username")
+	// use the username from the ID, when called from update this is
+	// the user the policy is currently attached to.
+	delDoc["user_name"] = userPolicyUsername(d)
 
 	url := c.makeRestEndpointURL("/s3/policies/detach")
 	payload, err := json.Marshal(delDoc)
@@ -166,7 +205,7 @@ func resourceUserPolicyCreate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("username").(string), d.Get("s3_policy_name").(string)))
 
 	return diags
 }
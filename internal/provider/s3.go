@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type WekaS3Cluster struct {
+	Data struct {
+		Active       bool     `json:"active"`
+		Filesystem   string   `json:"filesystem"`
+		ConfigFsName string   `json:"config_fs_name"`
+		Port         int      `json:"port"`
+		Domain       string   `json:"domain"`
+		AllHosts     bool     `json:"all_hosts"`
+		Hosts        []string `json:"hosts"`
+		Status       string   `json:"status"`
+	} `json:"data"`
+}
+
+func getS3Cluster(c *WekaClient) (*WekaS3Cluster, error) {
+	url := c.makeRestEndpointURL("s3")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var cluster WekaS3Cluster
+
+	if err := json.Unmarshal(body, &cluster); err != nil {
+		return nil, err
+	}
+
+	return &cluster, nil
+}
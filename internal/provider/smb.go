@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type WekaSMBCluster struct {
+	Data struct {
+		ClusterName       string   `json:"name"`
+		Domain            string   `json:"domain"`
+		DomainNetbiosName string   `json:"domain_netbios_name"`
+		ActiveDirectory   bool     `json:"active_directory"`
+		Encryption        string   `json:"encryption"`
+		SmbConfFsName     string   `json:"smb_conf_fs_name"`
+		Status            string   `json:"status"`
+		Hosts             []string `json:"hosts"`
+		Ips               []string `json:"ips"`
+		SmbwEnabled       bool     `json:"smbw_enabled"`
+	} `json:"data"`
+}
+
+func getSMBCluster(c *WekaClient) (*WekaSMBCluster, error) {
+	url := c.makeRestEndpointURL("smb")
+	req, err := http.NewRequest("GET", url.String(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.makeRequest(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var cluster WekaSMBCluster
+
+	if err := json.Unmarshal(body, &cluster); err != nil {
+		return nil, err
+	}
+
+	return &cluster, nil
+}
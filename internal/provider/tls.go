@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+)
+
+// parsePEMCertificates returns every CERTIFICATE block in s, in the
+// order they appear.
+func parsePEMCertificates(s string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(s)
+
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificates found")
+	}
+
+	return certs, nil
+}
+
+// validateCertificateChain checks that the private key belongs to the
+// first certificate and, if a chain is given, that the certificate is
+// signed by it. Any certificates following the first one in
+// certificate are treated as part of the chain.
+func validateCertificateChain(certificate, privateKey, chain string) (*x509.Certificate, error) {
+	if _, err := tls.X509KeyPair([]byte(certificate), []byte(privateKey)); err != nil {
+		return nil, fmt.Errorf("certificate and private key do not match: %s", err)
+	}
+
+	certs, err := parsePEMCertificates(certificate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	leaf := certs[0]
+	intermediates := certs[1:]
+
+	if chain != "" {
+		chainCerts, err := parsePEMCertificates(chain)
+
+		if err != nil {
+			return nil, fmt.Errorf("ca_chain: %s", err)
+		}
+
+		intermediates = append(intermediates, chainCerts...)
+	}
+
+	// nothing to verify against, the certificate may be signed by a
+	// CA the cluster already trusts.
+	if len(intermediates) == 0 {
+		return leaf, nil
+	}
+
+	// the last certificate in the chain is trusted as the root, the
+	// rest are intermediates.
+	roots := x509.NewCertPool()
+	roots.AddCert(intermediates[len(intermediates)-1])
+
+	pool := x509.NewCertPool()
+	for _, cert := range intermediates[:len(intermediates)-1] {
+		pool.AddCert(cert)
+	}
+
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: pool,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("certificate chain does not verify: %s", err)
+	}
+
+	return leaf, nil
+}
+
+func validatePEMCertificates(val any, key string) (warns []string, errs []error) {
+	if _, err := parsePEMCertificates(val.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q: %s", key, err))
+	}
+
+	return
+}
+
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}